@@ -1,40 +1,125 @@
 package flam
 
 import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"os/signal"
 	"slices"
 	"sync"
+	"syscall"
+	"time"
 
 	"go.uber.org/dig"
 )
 
+const (
+	DefaultGracePeriod = 10 * time.Second
+	DefaultBootWorkers = 1
+)
+
+const (
+	phaseBoot  = "boot"
+	phaseRun   = "run"
+	phaseClose = "close"
+)
+
 type Application interface {
+	State() ApplicationState
 	Container() *dig.Container
+	Scope() *dig.Scope
+	Events() PubSub[string, string]
 	Register(provider Provider) error
+	Unregister(id string) error
+	Providers() []ProviderInfo
+	Provider(id string) (Provider, error)
 	Boot() error
 	Run() error
+	RunContext(ctx context.Context) error
 	Close() error
+	Reload(id string, provider ...Provider) error
+	Supervise(id string, policy RestartPolicy, worker Worker) error
+	Execute(args []string) int
+	Health(ctx context.Context, probe HealthProbe) HealthReport
+	Graph() (Graph, error)
+	StartupReport() StartupReport
+}
+
+type applicationTask struct {
+	id  string
+	run func(ctx context.Context) error
 }
 
 type application struct {
-	locker    sync.Locker
-	container *dig.Container
-	providers []Provider
-	isBooted  bool
+	locker          sync.Locker
+	lifecycleLocker sync.Locker
+	containerLocker sync.Locker
+	container       *dig.Container
+	scope           *dig.Scope
+	events          PubSub[string, string]
+	providers       []Provider
+	records         map[string]*providerRecord
+	workers         []applicationTask
+	owners          map[string][]string
+	tracking        bool
+	state           applicationStateHolder
+	gracePeriod     time.Duration
+	bootWorkers     int
+	timeouts        Bag
+	output          io.Writer
+	banner          bool
+	started         time.Time
+	bootDuration    time.Duration
 }
 
-func NewApplication() Application {
-	return &application{
-		locker:    &sync.Mutex{},
-		container: dig.New(),
-		providers: []Provider{},
-		isBooted:  false,
+func NewApplication(
+	options ...ApplicationOption,
+) Application {
+	app := &application{
+		locker:          &sync.Mutex{},
+		lifecycleLocker: &sync.Mutex{},
+		containerLocker: &sync.Mutex{},
+		container:       dig.New(),
+		events:          NewPubSub[string, string](),
+		providers:       []Provider{},
+		records:         map[string]*providerRecord{},
+		owners:          map[string][]string{},
+		gracePeriod:     DefaultGracePeriod,
+		bootWorkers:     DefaultBootWorkers,
+		timeouts:        Bag{},
+		output:          os.Stderr,
+		started:         time.Now(),
 	}
+
+	for _, option := range options {
+		option(app)
+	}
+	app.scope = app.container.Scope("flam")
+	lockContainer(app, app.container, app.containerLocker)
+
+	return app
+}
+
+func (app *application) State() ApplicationState {
+	return app.state.get()
 }
 
 func (app *application) Container() *dig.Container {
 	return app.container
 }
 
+func (app *application) Scope() *dig.Scope {
+	app.locker.Lock()
+	defer app.locker.Unlock()
+
+	return app.scope
+}
+
+func (app *application) Events() PubSub[string, string] {
+	return app.events
+}
+
 func (app *application) Register(
 	provider Provider,
 ) error {
@@ -42,79 +127,985 @@ func (app *application) Register(
 		return newErrNilReference("provider")
 	}
 
+	app.lifecycleLocker.Lock()
+	defer app.lifecycleLocker.Unlock()
+
 	app.locker.Lock()
 	defer app.locker.Unlock()
 
-	for _, registered := range app.providers {
-		if registered.Id() == provider.Id() {
-			return newErrDuplicateProvider(provider.Id())
+	if !slices.Contains([]ApplicationState{ApplicationCreated, ApplicationRegistered}, app.state.get()) {
+		return newErrInvalidApplicationState("register", app.state.get())
+	}
+
+	entries, e := flattenProvider(provider, "")
+	if e != nil {
+		return e
+	}
+
+	for _, entry := range entries {
+		if record, ok := app.records[entry.provider.Id()]; ok {
+			registered := moduleEntry{provider: entry.provider, module: record.module}
+			if record.module == "" && entry.module == "" {
+				return newErrDuplicateProvider(entry.provider.Id())
+			}
+			return newErrDuplicateModuleProvider(entry.provider.Id(), registered.path(), entry.path())
 		}
 	}
 
-	if e := provider.Register(app.container); e != nil {
+	constructors, e := app.snapshot()
+	if e != nil {
 		return e
 	}
-	app.providers = append(app.providers, provider)
+
+	var registered []string
+	for _, entry := range entries {
+		start := time.Now()
+		if e := entry.provider.Register(app.container); e != nil {
+			if entry.module == "" {
+				return e
+			}
+			return newErrModuleRegister(entry.path(), registered, e)
+		}
+
+		app.providers = append(app.providers, entry.provider)
+		app.records[entry.provider.Id()] = &providerRecord{
+			module:           entry.module,
+			state:            ProviderRegistered,
+			registerDuration: time.Since(start),
+		}
+		app.state.set(ApplicationRegistered)
+		registered = append(registered, entry.path())
+
+		if constructors, e = app.track(entry.provider.Id(), constructors); e != nil {
+			return e
+		}
+	}
 
 	return nil
 }
 
-func (app *application) Boot() error {
-	if app.isBooted {
-		return nil
+func (app *application) Unregister(
+	id string,
+) error {
+	app.lifecycleLocker.Lock()
+	defer app.lifecycleLocker.Unlock()
+
+	switch state := app.state.get(); state {
+	case ApplicationCreated, ApplicationRegistered, ApplicationBooted, ApplicationRunning:
+	default:
+		return newErrInvalidApplicationState("unregister", state)
 	}
 
+	app.locker.Lock()
+	index := slices.IndexFunc(app.providers, func(registered Provider) bool {
+		return registered.Id() == id
+	})
+	if index < 0 {
+		app.locker.Unlock()
+		return newErrUnknownProvider(id)
+	}
+	provider := app.providers[index]
+	record := *app.records[id]
+
+	var dependents []string
+	for _, registered := range app.providers {
+		if dependent, ok := registered.(DependentProvider); ok && slices.Contains(dependent.DependsOn(), id) {
+			dependents = append(dependents, registered.Id())
+		}
+	}
+	app.locker.Unlock()
+
+	if len(dependents) != 0 {
+		return newErrProviderHasDependents(id, dependents)
+	}
+
+	if record.state == ProviderBooted {
+		if e := app.close(provider); e != nil {
+			return e
+		}
+	}
+
+	app.locker.Lock()
+	app.providers = slices.Delete(app.providers, index, index+1)
+	delete(app.records, id)
+	app.locker.Unlock()
+
+	return nil
+}
+
+func (app *application) Providers() []ProviderInfo {
 	app.locker.Lock()
 	defer app.locker.Unlock()
 
+	infos := make([]ProviderInfo, 0, len(app.providers))
 	for _, registered := range app.providers {
-		if bootable, ok := registered.(BootableProvider); ok {
-			if e := bootable.Boot(app.container); e != nil {
-				return e
-			}
+		record := app.records[registered.Id()]
+		infos = append(infos, ProviderInfo{
+			Id:           registered.Id(),
+			Module:       record.module,
+			Interfaces:   providerInterfaces(registered),
+			State:        record.state,
+			BootDuration: record.bootDuration,
+		})
+	}
+
+	return infos
+}
+
+func (app *application) Provider(
+	id string,
+) (Provider, error) {
+	app.locker.Lock()
+	defer app.locker.Unlock()
+
+	for _, registered := range app.providers {
+		if registered.Id() == id {
+			return registered, nil
+		}
+	}
+
+	return nil, newErrUnknownProvider(id)
+}
+
+func (app *application) Boot() error {
+	return app.boot(nil)
+}
+
+func (app *application) boot(
+	required []string,
+) error {
+	app.lifecycleLocker.Lock()
+	defer app.lifecycleLocker.Unlock()
+
+	switch app.state.get() {
+	case ApplicationCreated, ApplicationRegistered:
+	case ApplicationBooted, ApplicationRunning:
+		return nil
+	default:
+		return newErrInvalidApplicationState("boot", app.state.get())
+	}
+
+	if e := app.events.Publish(EventBeforeBoot); e != nil {
+		return e
+	}
+
+	app.locker.Lock()
+	providers, e := sortProviders(app.providers)
+	app.locker.Unlock()
+	if e != nil {
+		return e
+	}
+
+	if required != nil {
+		if providers, e = requiredProviders(providers, required); e != nil {
+			return e
 		}
 	}
 
-	app.isBooted = true
+	start := time.Now()
+	booted, e := app.bootProviders(providers)
+
+	app.locker.Lock()
+	app.bootDuration = time.Since(start)
+	app.locker.Unlock()
+
+	if e != nil {
+		return errors.Join(e, app.rollback(booted))
+	}
+
+	app.state.set(ApplicationBooted)
+
+	if app.banner {
+		_, _ = io.WriteString(app.output, app.StartupReport().Table())
+	}
 
 	return nil
 }
 
 func (app *application) Run() error {
-	if !app.isBooted {
-		if e := app.Boot(); e != nil {
+	providers, e := app.start()
+	if e != nil {
+		return e
+	}
+
+	for _, task := range app.tasks(providers) {
+		if e := task.run(context.Background()); e != nil {
 			return e
 		}
 	}
 
+	return nil
+}
+
+func (app *application) RunContext(
+	ctx context.Context,
+) error {
+	providers, e := app.start()
+	if e != nil {
+		return e
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errLocker := &sync.Mutex{}
+	var runErr error
+
+	wg := &sync.WaitGroup{}
+	for _, task := range app.tasks(providers) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if e := task.run(ctx); e != nil && (ctx.Err() == nil || !errors.Is(e, context.Canceled)) {
+				errLocker.Lock()
+				if runErr == nil {
+					runErr = e
+				}
+				errLocker.Unlock()
+
+				cancel()
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-ctx.Done():
+	case <-done:
+	}
+	stop()
+	cancel()
+
+	var graceErr error
+	select {
+	case <-done:
+	case <-time.After(app.gracePeriod):
+		graceErr = newErrShutdownTimeout(app.gracePeriod)
+	}
+
+	errLocker.Lock()
+	e = runErr
+	errLocker.Unlock()
+
+	return errors.Join(e, graceErr, app.Close())
+}
+
+func (app *application) Close() error {
+	app.lifecycleLocker.Lock()
+	app.locker.Lock()
+	if state := app.state.get(); state == ApplicationClosing || state == ApplicationClosed {
+		app.locker.Unlock()
+		app.lifecycleLocker.Unlock()
+		return nil
+	}
+	app.state.set(ApplicationClosing)
+
+	providers, e := sortProviders(app.providers)
+	if e != nil {
+		providers = slices.Clone(app.providers)
+	}
+	providers = slices.DeleteFunc(providers, func(registered Provider) bool {
+		return app.records[registered.Id()].state != ProviderBooted
+	})
+	app.locker.Unlock()
+	app.lifecycleLocker.Unlock()
+
+	slices.Reverse(providers)
+
+	var errs []error
+	if e := app.events.Publish(EventBeforeClose); e != nil {
+		errs = append(errs, e)
+	}
+
+	for _, registered := range providers {
+		if e := app.close(registered); e != nil {
+			errs = append(errs, e)
+		}
+	}
+
+	app.locker.Lock()
+	for _, registered := range providers {
+		if record, ok := app.records[registered.Id()]; ok {
+			record.state = ProviderClosed
+		}
+	}
+	app.state.set(ApplicationClosed)
+	app.locker.Unlock()
+
+	_ = app.events.Publish(EventClosed)
+
+	return errors.Join(errs...)
+}
+
+func (app *application) Reload(
+	id string,
+	provider ...Provider,
+) error {
+	app.lifecycleLocker.Lock()
+	defer app.lifecycleLocker.Unlock()
+
+	if state := app.state.get(); state != ApplicationBooted && state != ApplicationRunning {
+		return newErrInvalidApplicationState("reload", state)
+	}
+
+	app.locker.Lock()
+	index := slices.IndexFunc(app.providers, func(registered Provider) bool {
+		return registered.Id() == id
+	})
+	if index < 0 {
+		app.locker.Unlock()
+		return newErrUnknownProvider(id)
+	}
+	current := app.providers[index]
+	parent := app.scope
+	app.locker.Unlock()
+
+	replacement := current
+	if len(provider) != 0 && provider[0] != nil {
+		replacement = provider[0]
+	}
+
+	if module, ok := replacement.(*Module); ok {
+		return newErrUnexpectedModule(module.namespace)
+	}
+
+	if replacement.Id() != id {
+		return newErrProviderIdMismatch(id, replacement.Id())
+	}
+
+	scoped, ok := replacement.(ScopedProvider)
+	if !ok {
+		return newErrProviderNotReloadable(id)
+	}
+
+	providers := slices.Clone(app.providers)
+	providers[index] = replacement
+	if _, e := sortProviders(providers); e != nil {
+		return e
+	}
+
+	constructors, e := app.snapshot()
+	if e != nil {
+		return e
+	}
+
+	scope := parent.Scope(id)
+	if e := scoped.RegisterScope(scope); e != nil {
+		return e
+	}
+
+	app.locker.Lock()
+	_, e = app.track(id, constructors)
+	app.locker.Unlock()
+	if e != nil {
+		return e
+	}
+
+	if e := app.close(current); e != nil {
+		app.record(id, ProviderFailed, 0)
+		return e
+	}
+	app.record(id, ProviderClosed, 0)
+
+	app.locker.Lock()
+	app.providers[index] = replacement
+	app.scope = scope
+	app.locker.Unlock()
+
+	start := time.Now()
+	if bootable, ok := replacement.(BootableScopedProvider); ok {
+		boot := func(context.Context) error {
+			return bootable.BootScope(scope)
+		}
+		if e := app.call(context.Background(), id, phaseBoot, boot); e != nil {
+			app.record(id, ProviderFailed, time.Since(start))
+			return e
+		}
+	}
+	app.record(id, ProviderBooted, time.Since(start))
+
+	_ = app.events.Publish(EventProviderReload, id)
+
+	return nil
+}
+
+func (app *application) Supervise(
+	id string,
+	policy RestartPolicy,
+	worker Worker,
+) error {
+	if worker == nil {
+		return newErrNilReference("worker")
+	}
+
+	app.lifecycleLocker.Lock()
+	defer app.lifecycleLocker.Unlock()
+
+	switch state := app.state.get(); state {
+	case ApplicationCreated, ApplicationRegistered, ApplicationBooted:
+	default:
+		return newErrInvalidApplicationState("supervise", state)
+	}
+
 	app.locker.Lock()
 	defer app.locker.Unlock()
 
-	for _, registered := range app.providers {
-		if runnable, ok := registered.(RunnableProvider); ok {
-			if e := runnable.Run(app.container); e != nil {
-				return e
-			}
+	for _, registered := range app.workers {
+		if registered.id == id {
+			return newErrDuplicateWorker(id)
 		}
 	}
 
+	app.workers = append(app.workers, applicationTask{
+		id: id,
+		run: (&supervisor{
+			id:     id,
+			policy: policy,
+			events: app.events,
+			run:    worker,
+		}).Run,
+	})
+
 	return nil
 }
 
-func (app *application) Close() error {
+func (app *application) Health(
+	ctx context.Context,
+	probe HealthProbe,
+) HealthReport {
+	app.locker.Lock()
+	providers := slices.Clone(app.providers)
+	app.locker.Unlock()
+
+	report := HealthReport{
+		Probe:      probe.String(),
+		Status:     HealthUp,
+		Components: []HealthComponent{{Name: "application", Status: HealthUp}},
+	}
+
+	switch state := app.state.get(); {
+	case probe == HealthLiveness && state == ApplicationClosed,
+		probe == HealthReadiness && state != ApplicationBooted && state != ApplicationRunning:
+		report.Components[0].Status = HealthDown
+		report.Components[0].Error = newErrInvalidApplicationState(probe.String(), state).Error()
+	}
+
+	var ids []string
+	var checks []func(ctx context.Context) error
+	for _, registered := range providers {
+		if check := app.checker(registered, probe); check != nil {
+			ids = append(ids, registered.Id())
+			checks = append(checks, check)
+		}
+
+		if checker, ok := registered.(ComponentHealthChecker); ok && probe == HealthReadiness {
+			for _, check := range checker.HealthChecks() {
+				ids = append(ids, registered.Id()+"."+check.Name)
+				checks = append(checks, check.Check)
+			}
+		}
+	}
+
+	components := make([]HealthComponent, len(checks))
+	wg := &sync.WaitGroup{}
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			start := time.Now()
+			e := check(ctx)
+			components[i] = HealthComponent{Name: ids[i], Status: HealthUp, Latency: time.Since(start)}
+			if e != nil {
+				components[i].Status = HealthDown
+				components[i].Error = e.Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	report.Components = append(report.Components, components...)
+	for _, component := range report.Components {
+		if component.Status != HealthUp {
+			report.Status = HealthDown
+		}
+	}
+
+	return report
+}
+
+func (app *application) Graph() (Graph, error) {
+	constructors, e := graphConstructors(app.container)
+	if e != nil {
+		return Graph{}, e
+	}
+
 	app.locker.Lock()
 	defer app.locker.Unlock()
 
-	slices.Reverse(app.providers)
+	used := map[string]int{}
+	for i := range constructors {
+		key := constructors[i].key()
+		if owners := app.owners[key]; used[key] < len(owners) {
+			constructors[i].Provider = owners[used[key]]
+		}
+		used[key]++
+	}
 
-	var e error
+	return Graph{Constructors: constructors}, nil
+}
+
+func (app *application) StartupReport() StartupReport {
+	app.locker.Lock()
+	defer app.locker.Unlock()
+
+	report := StartupReport{
+		Started:   app.started,
+		Boot:      app.bootDuration,
+		Providers: make([]ProviderTiming, 0, len(app.providers)),
+	}
 	for _, registered := range app.providers {
-		if closable, ok := registered.(ClosableProvider); ok {
-			if closeError := closable.Close(app.container); closeError != nil {
-				e = closeError
+		record := app.records[registered.Id()]
+		report.Providers = append(report.Providers, ProviderTiming{
+			Id:       registered.Id(),
+			State:    record.state,
+			Register: record.registerDuration,
+			Boot:     record.bootDuration,
+			RunStart: record.runStart,
+			Close:    record.closeDuration,
+		})
+	}
+
+	return report
+}
+
+func (app *application) start() ([]Provider, error) {
+	if e := app.Boot(); e != nil {
+		return nil, e
+	}
+
+	app.lifecycleLocker.Lock()
+	defer app.lifecycleLocker.Unlock()
+
+	if app.state.get() != ApplicationBooted {
+		return nil, newErrInvalidApplicationState("run", app.state.get())
+	}
+
+	if e := app.events.Publish(EventBeforeRun); e != nil {
+		return nil, e
+	}
+
+	app.locker.Lock()
+	providers, e := sortProviders(app.providers)
+	app.locker.Unlock()
+	if e != nil {
+		return nil, e
+	}
+	app.state.set(ApplicationRunning)
+
+	return providers, nil
+}
+
+func (app *application) bootProviders(
+	providers []Provider,
+) ([]Provider, error) {
+	type result struct {
+		provider Provider
+		duration time.Duration
+		e        error
+	}
+
+	results := make(chan result)
+	pending := slices.Clone(providers)
+	booted := map[string]bool{}
+	failed := map[string]bool{}
+	skipped := map[string]bool{}
+	running := 0
+
+	var bootedProviders []Provider
+	var errs []error
+	for {
+		for i := 0; i < len(pending) && running < app.bootWorkers; {
+			registered := pending[i]
+			id := registered.Id()
+
+			ready := true
+			if dependent, ok := registered.(DependentProvider); ok {
+				for _, dependency := range dependent.DependsOn() {
+					switch {
+					case failed[dependency]:
+						failed[id] = true
+						ready = false
+					case skipped[dependency]:
+						skipped[id] = true
+						ready = false
+					case !booted[dependency]:
+						ready = false
+					}
+				}
+			}
+
+			switch {
+			case failed[id]:
+				pending = slices.Delete(pending, i, i+1)
+			case skipped[id]:
+				pending = slices.Delete(pending, i, i+1)
+				app.record(id, ProviderSkipped, 0)
+				_ = app.events.Publish(EventProviderSkipped, id)
+				i = 0
+			case !ready:
+				i++
+			default:
+				pending = slices.Delete(pending, i, i+1)
+				if conditional, ok := registered.(*ConditionalProvider); ok {
+					activated, e := app.activate(conditional)
+					switch {
+					case e != nil:
+						app.record(id, ProviderFailed, 0)
+						failed[id] = true
+						errs = append(errs, e)
+						i = 0
+						continue
+					case activated == nil:
+						app.record(id, ProviderSkipped, 0)
+						_ = app.events.Publish(EventProviderSkipped, id)
+						skipped[id] = true
+						i = 0
+						continue
+					}
+					registered = activated
+				}
+
+				boot := app.booter(registered)
+				if boot == nil {
+					app.record(id, ProviderBooted, 0)
+					booted[id] = true
+					bootedProviders = append(bootedProviders, registered)
+					i = 0
+					continue
+				}
+
+				running++
+				go func() {
+					start := time.Now()
+					e := app.call(context.Background(), id, phaseBoot, boot)
+					results <- result{provider: registered, duration: time.Since(start), e: e}
+				}()
 			}
 		}
+
+		if running == 0 {
+			break
+		}
+
+		r := <-results
+		running--
+		_ = app.events.Publish(EventProviderBooted, r.provider.Id(), r.duration, r.e)
+
+		if r.e != nil {
+			app.record(r.provider.Id(), ProviderFailed, r.duration)
+			failed[r.provider.Id()] = true
+			errs = append(errs, r.e)
+		} else {
+			app.record(r.provider.Id(), ProviderBooted, r.duration)
+			booted[r.provider.Id()] = true
+			bootedProviders = append(bootedProviders, r.provider)
+		}
 	}
 
-	return e
+	return bootedProviders, errors.Join(errs...)
+}
+
+func (app *application) activate(
+	conditional *ConditionalProvider,
+) (Provider, error) {
+	app.containerLocker.Lock()
+	defer app.containerLocker.Unlock()
+
+	if ok, e := conditional.Evaluate(app.container); e != nil || !ok {
+		return nil, e
+	}
+
+	provider := conditional.Provider()
+	constructors, e := app.snapshot()
+	if e != nil {
+		return nil, e
+	}
+
+	start := time.Now()
+	if e := provider.Register(app.container); e != nil {
+		return nil, e
+	}
+	duration := time.Since(start)
+
+	app.locker.Lock()
+	defer app.locker.Unlock()
+
+	if _, e := app.track(provider.Id(), constructors); e != nil {
+		return nil, e
+	}
+	if record, ok := app.records[provider.Id()]; ok {
+		record.registerDuration = duration
+	}
+	if index := slices.Index(app.providers, Provider(conditional)); index >= 0 {
+		app.providers[index] = provider
+	}
+
+	return provider, nil
+}
+
+func (app *application) close(
+	provider Provider,
+) error {
+	closer := app.closer(provider)
+	if closer == nil {
+		return nil
+	}
+
+	start := time.Now()
+	e := app.call(context.Background(), provider.Id(), phaseClose, closer)
+	app.measure(provider.Id(), func(record *providerRecord) {
+		record.closeDuration = time.Since(start)
+	})
+
+	if e != nil {
+		return newErrProviderClose(provider.Id(), e)
+	}
+
+	return nil
+}
+
+func (app *application) rollback(
+	booted []Provider,
+) error {
+	var errs []error
+	for _, registered := range slices.Backward(booted) {
+		if e := app.close(registered); e != nil {
+			errs = append(errs, e)
+		}
+		app.record(registered.Id(), ProviderClosed, 0)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (app *application) snapshot() ([]GraphConstructor, error) {
+	if !app.tracking {
+		return nil, nil
+	}
+
+	return graphConstructors(app.container)
+}
+
+func (app *application) track(
+	id string,
+	before []GraphConstructor,
+) ([]GraphConstructor, error) {
+	after, e := app.snapshot()
+	if e != nil || !app.tracking {
+		return before, e
+	}
+
+	counts := map[string]int{}
+	for _, constructor := range before {
+		counts[constructor.key()]++
+	}
+
+	for _, constructor := range after {
+		key := constructor.key()
+		if counts[key] > 0 {
+			counts[key]--
+			continue
+		}
+		app.owners[key] = append(app.owners[key], id)
+	}
+
+	return after, nil
+}
+
+func (app *application) record(
+	id string,
+	state ProviderState,
+	bootDuration time.Duration,
+) {
+	app.locker.Lock()
+	defer app.locker.Unlock()
+
+	if record, ok := app.records[id]; ok {
+		record.state = state
+		if state != ProviderClosed {
+			record.bootDuration = bootDuration
+		}
+	}
+}
+
+func (app *application) measure(
+	id string,
+	update func(record *providerRecord),
+) {
+	app.locker.Lock()
+	defer app.locker.Unlock()
+
+	if record, ok := app.records[id]; ok {
+		update(record)
+	}
+}
+
+func (app *application) tasks(
+	providers []Provider,
+) []applicationTask {
+	var tasks []applicationTask
+	for _, registered := range providers {
+		run := app.runner(registered)
+		if run == nil {
+			continue
+		}
+
+		id := registered.Id()
+		task := applicationTask{
+			id: id,
+			run: func(ctx context.Context) error {
+				app.measure(id, func(record *providerRecord) {
+					if record.runStart == 0 {
+						record.runStart = time.Since(app.started)
+					}
+				})
+				return app.call(ctx, id, phaseRun, run)
+			},
+		}
+
+		if supervised, ok := registered.(SupervisedProvider); ok {
+			task.run = (&supervisor{
+				id:     id,
+				policy: supervised.RestartPolicy(),
+				events: app.events,
+				run:    task.run,
+			}).Run
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	app.locker.Lock()
+	defer app.locker.Unlock()
+
+	return append(tasks, app.workers...)
+}
+
+func (app *application) booter(
+	provider Provider,
+) func(ctx context.Context) error {
+	switch bootable := provider.(type) {
+	case BootableContextProvider:
+		return func(ctx context.Context) error {
+			return bootable.BootContext(ctx, app.container)
+		}
+	case BootableProvider:
+		return func(context.Context) error {
+			return bootable.Boot(app.container)
+		}
+	default:
+		return nil
+	}
+}
+
+func (app *application) runner(
+	provider Provider,
+) func(ctx context.Context) error {
+	switch runnable := provider.(type) {
+	case RunnableContextProvider:
+		return func(ctx context.Context) error {
+			return runnable.RunContext(ctx, app.container)
+		}
+	case RunnableProvider:
+		return func(context.Context) error {
+			return runnable.Run(app.container)
+		}
+	default:
+		return nil
+	}
+}
+
+func (app *application) closer(
+	provider Provider,
+) func(ctx context.Context) error {
+	switch closable := provider.(type) {
+	case ClosableContextProvider:
+		return func(ctx context.Context) error {
+			return closable.CloseContext(ctx, app.container)
+		}
+	case ClosableProvider:
+		return func(context.Context) error {
+			return closable.Close(app.container)
+		}
+	default:
+		return nil
+	}
+}
+
+func (app *application) checker(
+	provider Provider,
+	probe HealthProbe,
+) func(ctx context.Context) error {
+	switch probe {
+	case HealthLiveness:
+		if checker, ok := provider.(LivenessChecker); ok {
+			return checker.CheckLiveness
+		}
+	case HealthReadiness:
+		if checker, ok := provider.(HealthChecker); ok {
+			return checker.Check
+		}
+	}
+
+	return nil
+}
+
+func (app *application) timeout(
+	id string,
+	phase string,
+) time.Duration {
+	timeouts := app.timeouts.Coerced()
+
+	return timeouts.Duration(BagPathJoin("providers", id, phase), timeouts.Duration(phase))
+}
+
+func (app *application) call(
+	ctx context.Context,
+	id string,
+	phase string,
+	fn func(ctx context.Context) error,
+) error {
+	timeout := app.timeout(id, phase)
+	if timeout <= 0 {
+		return fn(ctx)
+	}
+
+	cause := newErrProviderTimeout(id, phase, timeout)
+	ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+	defer cancel()
+
+	result := make(chan error, 1)
+	go func() {
+		result <- fn(ctx)
+	}()
+
+	select {
+	case e := <-result:
+		return e
+	case <-ctx.Done():
+		if context.Cause(ctx) == cause {
+			return cause
+		}
+		return <-result
+	}
 }
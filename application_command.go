@@ -0,0 +1,132 @@
+package flam
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	ExitSuccess = 0
+	ExitFailure = 1
+	ExitUsage   = 2
+)
+
+type applicationCommand struct {
+	command Command
+	owner   string
+	words   []string
+}
+
+func (app *application) Execute(
+	args []string,
+) int {
+	e := app.execute(args)
+	if e != nil && !errors.Is(e, flag.ErrHelp) {
+		_, _ = fmt.Fprintln(app.output, e)
+	}
+
+	return exitCode(e)
+}
+
+func (app *application) execute(
+	args []string,
+) error {
+	commands, e := app.commands()
+	if e != nil {
+		return e
+	}
+
+	var selected *applicationCommand
+	for _, command := range commands {
+		if len(command.words) <= len(args) && slices.Equal(command.words, args[:len(command.words)]) {
+			if selected == nil || len(command.words) > len(selected.words) {
+				selected = &command
+			}
+		}
+	}
+
+	if selected == nil {
+		app.usage(commands)
+		return newErrUnknownCommand(strings.Join(args, " "))
+	}
+
+	flags := flag.NewFlagSet(selected.command.Name, flag.ContinueOnError)
+	flags.SetOutput(app.output)
+	if selected.command.Flags != nil {
+		selected.command.Flags(flags)
+	}
+
+	if e := flags.Parse(args[len(selected.words):]); e != nil {
+		if errors.Is(e, flag.ErrHelp) {
+			return e
+		}
+		return newErrInvalidCommandArguments(selected.command.Name, e)
+	}
+
+	if e := app.boot(append([]string{selected.owner}, selected.command.Requires...)); e != nil {
+		return errors.Join(e, app.Close())
+	}
+
+	return errors.Join(selected.command.Handler(app.container, flags), app.Close())
+}
+
+func (app *application) commands() ([]applicationCommand, error) {
+	app.locker.Lock()
+	defer app.locker.Unlock()
+
+	var commands []applicationCommand
+	for _, registered := range app.providers {
+		provider, ok := registered.(CommandProvider)
+		if !ok {
+			continue
+		}
+
+		for _, command := range provider.Commands() {
+			if command.Handler == nil {
+				return nil, newErrNilReference(fmt.Sprintf("command(%s) handler", command.Name))
+			}
+
+			words := strings.Fields(command.Name)
+			for _, other := range commands {
+				if slices.Equal(other.words, words) {
+					return nil, newErrDuplicateCommand(command.Name, other.owner, registered.Id())
+				}
+			}
+
+			commands = append(commands, applicationCommand{
+				command: command,
+				owner:   registered.Id(),
+				words:   words,
+			})
+		}
+	}
+
+	return commands, nil
+}
+
+func (app *application) usage(
+	commands []applicationCommand,
+) {
+	_, _ = fmt.Fprintln(app.output, "commands:")
+	for _, command := range commands {
+		_, _ = fmt.Fprintf(app.output, "  %-20s %s\n", command.command.Name, command.command.Description)
+	}
+}
+
+func exitCode(
+	e error,
+) int {
+	if e == nil || errors.Is(e, flag.ErrHelp) {
+		return ExitSuccess
+	}
+
+	var flamErr Error
+	if errors.As(e, &flamErr) && flamErr.GetCode() != 0 {
+		return flamErr.GetCode()
+	}
+
+	return ExitFailure
+}
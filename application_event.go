@@ -0,0 +1,13 @@
+package flam
+
+const (
+	EventBeforeBoot      = "flam.application.before_boot"
+	EventProviderBooted  = "flam.application.provider_booted"
+	EventProviderSkipped = "flam.application.provider_skipped"
+	EventBeforeRun       = "flam.application.before_run"
+	EventBeforeClose     = "flam.application.before_close"
+	EventClosed          = "flam.application.closed"
+	EventProviderReload  = "flam.application.provider_reload"
+	EventWorkerCrashed   = "flam.application.worker_crashed"
+	EventWorkerRestart   = "flam.application.worker_restart"
+)
@@ -0,0 +1,52 @@
+package flam
+
+import (
+	"io"
+	"time"
+)
+
+type ApplicationOption func(app *application)
+
+func WithGracePeriod(
+	period time.Duration,
+) ApplicationOption {
+	return func(app *application) {
+		app.gracePeriod = period
+	}
+}
+
+func WithBootWorkers(
+	workers int,
+) ApplicationOption {
+	return func(app *application) {
+		app.bootWorkers = max(workers, 1)
+	}
+}
+
+func WithTimeouts(
+	config Bag,
+) ApplicationOption {
+	return func(app *application) {
+		app.timeouts = config.Clone()
+	}
+}
+
+func WithOutput(
+	output io.Writer,
+) ApplicationOption {
+	return func(app *application) {
+		app.output = output
+	}
+}
+
+func WithStartupBanner() ApplicationOption {
+	return func(app *application) {
+		app.banner = true
+	}
+}
+
+func WithGraphTracking() ApplicationOption {
+	return func(app *application) {
+		app.tracking = true
+	}
+}
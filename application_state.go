@@ -0,0 +1,49 @@
+package flam
+
+import (
+	"sync/atomic"
+)
+
+type ApplicationState int
+
+const (
+	ApplicationCreated ApplicationState = iota
+	ApplicationRegistered
+	ApplicationBooted
+	ApplicationRunning
+	ApplicationClosing
+	ApplicationClosed
+)
+
+func (state ApplicationState) String() string {
+	switch state {
+	case ApplicationCreated:
+		return "created"
+	case ApplicationRegistered:
+		return "registered"
+	case ApplicationBooted:
+		return "booted"
+	case ApplicationRunning:
+		return "running"
+	case ApplicationClosing:
+		return "closing"
+	case ApplicationClosed:
+		return "closed"
+	default:
+		return "unknown"
+	}
+}
+
+type applicationStateHolder struct {
+	value atomic.Int32
+}
+
+func (holder *applicationStateHolder) get() ApplicationState {
+	return ApplicationState(holder.value.Load())
+}
+
+func (holder *applicationStateHolder) set(
+	state ApplicationState,
+) {
+	holder.value.Store(int32(state))
+}
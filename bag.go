@@ -1,7 +1,8 @@
 package flam
 
 import (
-	"strings"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/mitchellh/mapstructure"
@@ -10,27 +11,9 @@ import (
 type Bag map[string]any
 
 func (bag *Bag) Clone() Bag {
-	var cloner func(value any) any
-	cloner = func(value any) any {
-		switch typedValue := value.(type) {
-		case []any:
-			var result []any
-			for _, i := range typedValue {
-				result = append(result, cloner(i))
-			}
-			return result
-		case Bag:
-			return typedValue.Clone()
-		case *Bag:
-			return typedValue.Clone()
-		default:
-			return value
-		}
-	}
-
 	target := Bag{}
 	for key, value := range *bag {
-		target[key] = cloner(value)
+		target[key] = bagClone(value)
 	}
 
 	return target
@@ -396,46 +379,106 @@ func (bag *Bag) Set(
 	path string,
 	value any,
 ) error {
-	if path == "" {
-		return newErrBagInvalidPath("")
+	parts, e := parseBagPath(path)
+	if e != nil {
+		return e
 	}
 
-	parts := strings.Split(path, ".")
-	it := bag
-	if len(parts) == 1 {
-		(*it)[path] = value
-		return nil
+	if len(parts) == 0 || parts[0].bracket {
+		return newErrBagInvalidPath(path)
 	}
 
-	generate := func(part string) {
-		generate := false
-		if next, ok := (*it)[part]; !ok {
-			generate = true
-		} else if _, ok = next.(Bag); !ok {
-			generate = true
-		}
-		if generate {
-			(*it)[part] = Bag{}
-		}
+	_, e = bagSet(*bag, parts, value, path)
+
+	return e
+}
+
+func (bag *Bag) Delete(
+	path string,
+	prune ...bool,
+) error {
+	parts, e := parseBagPath(path)
+	if e != nil {
+		return e
 	}
 
-	for _, part := range parts[:len(parts)-1] {
-		if part == "" {
-			continue
-		}
+	if len(parts) == 0 || parts[0].bracket {
+		return newErrBagInvalidPath(path)
+	}
+
+	_, e = bagDelete(*bag, parts, path, len(prune) != 0 && prune[0])
+
+	return e
+}
+
+func (bag *Bag) Move(
+	from string,
+	to string,
+	prune ...bool,
+) error {
+	value, e := bag.path(from)
+	if e != nil {
+		return e
+	}
 
-		generate(part)
-		next := (*it)[part].(Bag)
-		it = &next
+	source, _ := parseBagPath(from)
+	target, e := parseBagPath(to)
+	switch {
+	case e != nil:
+		return e
+	case len(source) == 0:
+		return newErrBagInvalidPath(from)
+	case len(target) == 0 || target[0].bracket:
+		return newErrBagInvalidPath(to)
+	case len(target) > len(source) && slices.Equal(source, target[:len(source)]):
+		return newErrBagInvalidPath(to)
 	}
 
-	part := parts[len(parts)-1:][0]
-	generate(part)
-	(*it)[part] = value
+	dry := bag.Clone()
+	if e := dry.Delete(from, prune...); e != nil {
+		return e
+	}
+
+	if e := dry.Set(to, value); e != nil {
+		return e
+	}
+
+	_ = bag.Delete(from, prune...)
+	_ = bag.Set(to, value)
 
 	return nil
 }
 
+func (bag *Bag) Copy(
+	from string,
+	to string,
+) error {
+	value, e := bag.path(from)
+	if e != nil {
+		return e
+	}
+
+	return bag.Set(to, bagClone(value))
+}
+
+func (bag *Bag) Keys(
+	path string,
+) ([]string, error) {
+	value, e := bag.path(path)
+	if e != nil {
+		return nil, e
+	}
+
+	switch typedValue := value.(type) {
+	case Bag:
+		return slices.Sorted(maps.Keys(typedValue)), nil
+	case *Bag:
+		return slices.Sorted(maps.Keys(*typedValue)), nil
+	default:
+		return nil, newErrBagInvalidPath(path)
+	}
+}
+
 func (bag *Bag) Merge(
 	src Bag,
 ) *Bag {
@@ -491,24 +534,31 @@ func (bag *Bag) Populate(
 func (bag *Bag) path(
 	path string,
 ) (any, error) {
+	parts, e := parseBagPath(path)
+	if e != nil {
+		return nil, e
+	}
+
 	var ok bool
 	var it any
 
 	it = *bag
-	for _, part := range strings.Split(path, ".") {
-		if part == "" {
-			continue
-		}
-
+	for _, part := range parts {
 		switch typedIt := it.(type) {
 		case Bag:
-			if it, ok = typedIt[part]; !ok {
+			if it, ok = typedIt[part.key]; part.bracket || !ok {
 				return nil, newErrBagInvalidPath(path)
 			}
 		case *Bag:
-			if it, ok = (*typedIt)[part]; !ok {
+			if it, ok = (*typedIt)[part.key]; part.bracket || !ok {
+				return nil, newErrBagInvalidPath(path)
+			}
+		case []any:
+			index, ok := bagIndex(typedIt, part.key)
+			if part.literal || !ok {
 				return nil, newErrBagInvalidPath(path)
 			}
+			it = typedIt[index]
 		default:
 			return nil, newErrBagInvalidPath(path)
 		}
@@ -0,0 +1,336 @@
+package flam
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type bagSigned interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+type bagUnsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+type bagFloat interface {
+	~float32 | ~float64
+}
+
+func (bag *Bag) BoolE(
+	path string,
+) (bool, error) {
+	return bagCoerce(bag, path, "bool", coerceBool)
+}
+
+func (bag *Bag) IntE(
+	path string,
+) (int, error) {
+	return bagCoerce(bag, path, "int", coerceSigned[int])
+}
+
+func (bag *Bag) Int8E(
+	path string,
+) (int8, error) {
+	return bagCoerce(bag, path, "int8", coerceSigned[int8])
+}
+
+func (bag *Bag) Int16E(
+	path string,
+) (int16, error) {
+	return bagCoerce(bag, path, "int16", coerceSigned[int16])
+}
+
+func (bag *Bag) Int32E(
+	path string,
+) (int32, error) {
+	return bagCoerce(bag, path, "int32", coerceSigned[int32])
+}
+
+func (bag *Bag) Int64E(
+	path string,
+) (int64, error) {
+	return bagCoerce(bag, path, "int64", coerceSigned[int64])
+}
+
+func (bag *Bag) UintE(
+	path string,
+) (uint, error) {
+	return bagCoerce(bag, path, "uint", coerceUnsigned[uint])
+}
+
+func (bag *Bag) Uint8E(
+	path string,
+) (uint8, error) {
+	return bagCoerce(bag, path, "uint8", coerceUnsigned[uint8])
+}
+
+func (bag *Bag) Uint16E(
+	path string,
+) (uint16, error) {
+	return bagCoerce(bag, path, "uint16", coerceUnsigned[uint16])
+}
+
+func (bag *Bag) Uint32E(
+	path string,
+) (uint32, error) {
+	return bagCoerce(bag, path, "uint32", coerceUnsigned[uint32])
+}
+
+func (bag *Bag) Uint64E(
+	path string,
+) (uint64, error) {
+	return bagCoerce(bag, path, "uint64", coerceUnsigned[uint64])
+}
+
+func (bag *Bag) Float32E(
+	path string,
+) (float32, error) {
+	return bagCoerce(bag, path, "float32", coerceFloat[float32])
+}
+
+func (bag *Bag) Float64E(
+	path string,
+) (float64, error) {
+	return bagCoerce(bag, path, "float64", coerceFloat[float64])
+}
+
+func (bag *Bag) StringE(
+	path string,
+) (string, error) {
+	return bagCoerce(bag, path, "string", coerceString)
+}
+
+func (bag *Bag) DurationE(
+	path string,
+) (time.Duration, error) {
+	return bagCoerce(bag, path, "time.Duration", coerceDuration)
+}
+
+func bagCoerce[T any](
+	bag *Bag,
+	path string,
+	target string,
+	coerce func(value any) (T, error),
+) (T, error) {
+	var zero T
+
+	value, e := bag.path(path)
+	if e != nil {
+		return zero, e
+	}
+
+	result, e := coerce(value)
+	if e != nil {
+		return zero, newErrBagConversion(e, path, value, target)
+	}
+
+	return result, nil
+}
+
+func coerceBool(
+	value any,
+) (bool, error) {
+	switch val := reflect.ValueOf(value); val.Kind() {
+	case reflect.Bool:
+		return val.Bool(), nil
+	case reflect.String:
+		if result, e := strconv.ParseBool(strings.TrimSpace(val.String())); e == nil {
+			return result, nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, e := coerceSigned[int64](value); e == nil && (n == 0 || n == 1) {
+			return n == 1, nil
+		}
+	default:
+	}
+
+	return false, ErrBagValueConversion
+}
+
+func coerceSigned[T bagSigned](
+	value any,
+) (T, error) {
+	bits := reflect.TypeFor[T]().Bits()
+	minimum := int64(-1) << (bits - 1)
+	maximum := -(minimum + 1)
+
+	fromFloat := func(f float64) (T, error) {
+		switch {
+		case math.Trunc(f) != f:
+			return 0, ErrBagValueConversion
+		case f < float64(minimum) || f >= -float64(minimum):
+			return 0, ErrBagValueOverflow
+		}
+		return T(f), nil
+	}
+
+	switch val := reflect.ValueOf(value); val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n := val.Int(); n < minimum || n > maximum {
+			return 0, ErrBagValueOverflow
+		}
+		return T(val.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if val.Uint() > uint64(maximum) {
+			return 0, ErrBagValueOverflow
+		}
+		return T(val.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return fromFloat(val.Float())
+	case reflect.String:
+		s := strings.TrimSpace(val.String())
+		n, e := strconv.ParseInt(s, 10, bits)
+		if e == nil {
+			return T(n), nil
+		}
+		if f, e := strconv.ParseFloat(s, 64); e == nil {
+			return fromFloat(f)
+		}
+		if isRangeError(e) {
+			return 0, ErrBagValueOverflow
+		}
+	default:
+	}
+
+	return 0, ErrBagValueConversion
+}
+
+func coerceUnsigned[T bagUnsigned](
+	value any,
+) (T, error) {
+	bits := reflect.TypeFor[T]().Bits()
+	maximum := uint64(math.MaxUint64) >> (64 - bits)
+
+	fromFloat := func(f float64) (T, error) {
+		switch {
+		case math.Trunc(f) != f:
+			return 0, ErrBagValueConversion
+		case f < 0 || f >= math.Ldexp(1, bits):
+			return 0, ErrBagValueOverflow
+		}
+		return T(f), nil
+	}
+
+	switch val := reflect.ValueOf(value); val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n := val.Int(); n < 0 || uint64(n) > maximum {
+			return 0, ErrBagValueOverflow
+		}
+		return T(val.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if val.Uint() > maximum {
+			return 0, ErrBagValueOverflow
+		}
+		return T(val.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return fromFloat(val.Float())
+	case reflect.String:
+		s := strings.TrimSpace(val.String())
+		n, e := strconv.ParseUint(s, 10, bits)
+		if e == nil {
+			return T(n), nil
+		}
+		if f, e := strconv.ParseFloat(s, 64); e == nil {
+			return fromFloat(f)
+		}
+		if isRangeError(e) {
+			return 0, ErrBagValueOverflow
+		}
+	default:
+	}
+
+	return 0, ErrBagValueConversion
+}
+
+func coerceFloat[T bagFloat](
+	value any,
+) (T, error) {
+	bits := reflect.TypeFor[T]().Bits()
+
+	fromFloat := func(f float64) (T, error) {
+		if bits == 32 && !math.IsInf(f, 0) && math.Abs(f) > math.MaxFloat32 {
+			return 0, ErrBagValueOverflow
+		}
+		return T(f), nil
+	}
+
+	switch val := reflect.ValueOf(value); val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return T(val.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return T(val.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return fromFloat(val.Float())
+	case reflect.String:
+		f, e := strconv.ParseFloat(strings.TrimSpace(val.String()), 64)
+		if e == nil {
+			return fromFloat(f)
+		}
+		if isRangeError(e) {
+			return 0, ErrBagValueOverflow
+		}
+	default:
+	}
+
+	return 0, ErrBagValueConversion
+}
+
+func coerceString(
+	value any,
+) (string, error) {
+	if stringer, ok := value.(fmt.Stringer); ok {
+		return stringer.String(), nil
+	}
+
+	switch val := reflect.ValueOf(value); val.Kind() {
+	case reflect.String:
+		return val.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(val.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(val.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(val.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(val.Float(), 'g', -1, val.Type().Bits()), nil
+	default:
+	}
+
+	return "", ErrBagValueConversion
+}
+
+func coerceDuration(
+	value any,
+) (time.Duration, error) {
+	switch val := value.(type) {
+	case time.Duration:
+		return val, nil
+	case string:
+		if duration, e := time.ParseDuration(strings.TrimSpace(val)); e == nil {
+			return duration, nil
+		}
+	}
+
+	ms, e := coerceSigned[int64](value)
+	if e != nil {
+		return 0, e
+	}
+	if ms > math.MaxInt64/int64(time.Millisecond) || ms < math.MinInt64/int64(time.Millisecond) {
+		return 0, ErrBagValueOverflow
+	}
+
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+func isRangeError(
+	e error,
+) bool {
+	numErr, ok := e.(*strconv.NumError)
+	return ok && numErr.Err == strconv.ErrRange
+}
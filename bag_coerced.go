@@ -0,0 +1,156 @@
+package flam
+
+import (
+	"time"
+)
+
+type CoercedBag struct {
+	bag *Bag
+}
+
+func (bag *Bag) Coerced() CoercedBag {
+	return CoercedBag{bag: bag}
+}
+
+func (coerced CoercedBag) Has(
+	path string,
+) bool {
+	return coerced.bag.Has(path)
+}
+
+func (coerced CoercedBag) Get(
+	path string,
+	def ...any,
+) any {
+	return coerced.bag.Get(path, def...)
+}
+
+func (coerced CoercedBag) Bag(
+	path string,
+	def ...Bag,
+) CoercedBag {
+	bag := coerced.bag.Bag(path, def...)
+	return bag.Coerced()
+}
+
+func (coerced CoercedBag) Bool(
+	path string,
+	def ...bool,
+) bool {
+	return coercedValue(coerced.bag.BoolE(path))(def)
+}
+
+func (coerced CoercedBag) Int(
+	path string,
+	def ...int,
+) int {
+	return coercedValue(coerced.bag.IntE(path))(def)
+}
+
+func (coerced CoercedBag) Int8(
+	path string,
+	def ...int8,
+) int8 {
+	return coercedValue(coerced.bag.Int8E(path))(def)
+}
+
+func (coerced CoercedBag) Int16(
+	path string,
+	def ...int16,
+) int16 {
+	return coercedValue(coerced.bag.Int16E(path))(def)
+}
+
+func (coerced CoercedBag) Int32(
+	path string,
+	def ...int32,
+) int32 {
+	return coercedValue(coerced.bag.Int32E(path))(def)
+}
+
+func (coerced CoercedBag) Int64(
+	path string,
+	def ...int64,
+) int64 {
+	return coercedValue(coerced.bag.Int64E(path))(def)
+}
+
+func (coerced CoercedBag) Uint(
+	path string,
+	def ...uint,
+) uint {
+	return coercedValue(coerced.bag.UintE(path))(def)
+}
+
+func (coerced CoercedBag) Uint8(
+	path string,
+	def ...uint8,
+) uint8 {
+	return coercedValue(coerced.bag.Uint8E(path))(def)
+}
+
+func (coerced CoercedBag) Uint16(
+	path string,
+	def ...uint16,
+) uint16 {
+	return coercedValue(coerced.bag.Uint16E(path))(def)
+}
+
+func (coerced CoercedBag) Uint32(
+	path string,
+	def ...uint32,
+) uint32 {
+	return coercedValue(coerced.bag.Uint32E(path))(def)
+}
+
+func (coerced CoercedBag) Uint64(
+	path string,
+	def ...uint64,
+) uint64 {
+	return coercedValue(coerced.bag.Uint64E(path))(def)
+}
+
+func (coerced CoercedBag) Float32(
+	path string,
+	def ...float32,
+) float32 {
+	return coercedValue(coerced.bag.Float32E(path))(def)
+}
+
+func (coerced CoercedBag) Float64(
+	path string,
+	def ...float64,
+) float64 {
+	return coercedValue(coerced.bag.Float64E(path))(def)
+}
+
+func (coerced CoercedBag) String(
+	path string,
+	def ...string,
+) string {
+	return coercedValue(coerced.bag.StringE(path))(def)
+}
+
+func (coerced CoercedBag) Duration(
+	path string,
+	def ...time.Duration,
+) time.Duration {
+	return coercedValue(coerced.bag.DurationE(path))(def)
+}
+
+func coercedValue[T any](
+	value T,
+	e error,
+) func(def []T) T {
+	return func(def []T) T {
+		if e == nil {
+			return value
+		}
+		if len(def) != 0 {
+			return def[0]
+		}
+
+		var zero T
+		return zero
+	}
+}
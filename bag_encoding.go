@@ -0,0 +1,96 @@
+package flam
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+var _ json.Marshaler = Bag{}
+var _ json.Unmarshaler = &Bag{}
+var _ yaml.Marshaler = Bag{}
+var _ yaml.Unmarshaler = &Bag{}
+
+func ParseJSON(
+	data []byte,
+) (Bag, error) {
+	bag := Bag{}
+	if e := json.Unmarshal(data, &bag); e != nil {
+		return nil, e
+	}
+
+	return bag, nil
+}
+
+func ParseYAML(
+	data []byte,
+) (Bag, error) {
+	bag := Bag{}
+	if e := yaml.Unmarshal(data, &bag); e != nil {
+		return nil, e
+	}
+
+	return bag, nil
+}
+
+func (bag Bag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]any(bag))
+}
+
+func (bag *Bag) UnmarshalJSON(
+	data []byte,
+) error {
+	var source map[string]any
+	if e := json.Unmarshal(data, &source); e != nil {
+		return e
+	}
+
+	*bag = bagNormalize(source).(Bag)
+
+	return nil
+}
+
+func (bag Bag) MarshalYAML() (any, error) {
+	return map[string]any(bag), nil
+}
+
+func (bag *Bag) UnmarshalYAML(
+	node *yaml.Node,
+) error {
+	var source map[string]any
+	if e := node.Decode(&source); e != nil {
+		return e
+	}
+
+	*bag = bagNormalize(source).(Bag)
+
+	return nil
+}
+
+func bagNormalize(
+	value any,
+) any {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		result := Bag{}
+		for key, item := range typedValue {
+			result[key] = bagNormalize(item)
+		}
+		return result
+	case map[any]any:
+		result := Bag{}
+		for key, item := range typedValue {
+			result[fmt.Sprint(key)] = bagNormalize(item)
+		}
+		return result
+	case []any:
+		result := make([]any, 0, len(typedValue))
+		for _, item := range typedValue {
+			result = append(result, bagNormalize(item))
+		}
+		return result
+	default:
+		return value
+	}
+}
@@ -0,0 +1,278 @@
+package flam
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type bagPathPart struct {
+	key     string
+	bracket bool
+	literal bool
+}
+
+func BagPathJoin(
+	parts ...string,
+) string {
+	escaped := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part == "" {
+			escaped = append(escaped, `""`)
+			continue
+		}
+
+		builder := strings.Builder{}
+		for _, c := range part {
+			if strings.ContainsRune(`.[]"\`, c) {
+				builder.WriteByte('\\')
+			}
+			builder.WriteRune(c)
+		}
+		escaped = append(escaped, builder.String())
+	}
+
+	return strings.Join(escaped, ".")
+}
+
+func parseBagPath(
+	path string,
+) ([]bagPathPart, error) {
+	var parts []bagPathPart
+	key := strings.Builder{}
+	pending := false
+	literal := false
+
+	flush := func() {
+		if pending {
+			parts = append(parts, bagPathPart{key: key.String(), literal: literal})
+		}
+		key.Reset()
+		pending = false
+		literal = false
+	}
+	separated := func(i int) bool {
+		return i >= len(path) || path[i] == '.' || path[i] == '['
+	}
+
+	for i := 0; i < len(path); i++ {
+		switch c := path[i]; c {
+		case '\\':
+			if i++; i >= len(path) {
+				return nil, newErrBagInvalidPath(path)
+			}
+			key.WriteByte(path[i])
+			pending = true
+		case '"':
+			if pending {
+				return nil, newErrBagInvalidPath(path)
+			}
+
+			closed := false
+			for i++; i < len(path) && !closed; i++ {
+				switch path[i] {
+				case '\\':
+					if i++; i >= len(path) {
+						return nil, newErrBagInvalidPath(path)
+					}
+					key.WriteByte(path[i])
+				case '"':
+					closed = true
+				default:
+					key.WriteByte(path[i])
+				}
+			}
+			if i--; !closed || !separated(i+1) {
+				return nil, newErrBagInvalidPath(path)
+			}
+			pending = true
+			literal = true
+		case '.':
+			flush()
+		case '[':
+			flush()
+
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, newErrBagInvalidPath(path)
+			}
+
+			index := path[i+1 : i+end]
+			if _, e := strconv.Atoi(index); index != "" && e != nil {
+				return nil, newErrBagInvalidPath(path)
+			}
+
+			parts = append(parts, bagPathPart{key: index, bracket: true})
+			if i += end; !separated(i + 1) {
+				return nil, newErrBagInvalidPath(path)
+			}
+		default:
+			key.WriteByte(c)
+			pending = true
+		}
+	}
+	flush()
+
+	return parts, nil
+}
+
+func bagIndex(
+	list []any,
+	key string,
+) (int, bool) {
+	index, e := strconv.Atoi(key)
+	if e != nil {
+		return 0, false
+	}
+	if index < 0 {
+		index += len(list)
+	}
+
+	return index, index >= 0 && index < len(list)
+}
+
+func bagSet(
+	node any,
+	parts []bagPathPart,
+	value any,
+	path string,
+) (any, error) {
+	if len(parts) == 0 {
+		return value, nil
+	}
+	part, rest := parts[0], parts[1:]
+
+	list, isList := node.([]any)
+	if _, e := strconv.Atoi(part.key); part.bracket || (isList && !part.literal && e == nil) {
+		if part.key == "" {
+			child, e := bagSet(nil, rest, value, path)
+			if e != nil {
+				return nil, e
+			}
+			return append(list, child), nil
+		}
+
+		index, ok := bagIndex(list, part.key)
+		if !ok {
+			return nil, newErrBagInvalidPath(path)
+		}
+
+		child, e := bagSet(list[index], rest, value, path)
+		if e != nil {
+			return nil, e
+		}
+		list[index] = child
+
+		return list, nil
+	}
+
+	var target Bag
+	switch typedNode := node.(type) {
+	case Bag:
+		target = typedNode
+	case *Bag:
+		target = *typedNode
+	default:
+		target = Bag{}
+		node = target
+	}
+
+	child, e := bagSet(target[part.key], rest, value, path)
+	if e != nil {
+		return nil, e
+	}
+	target[part.key] = child
+
+	return node, nil
+}
+
+func bagDelete(
+	node any,
+	parts []bagPathPart,
+	path string,
+	prune bool,
+) (any, error) {
+	part, rest := parts[0], parts[1:]
+
+	switch typedNode := node.(type) {
+	case Bag, *Bag:
+		target, _ := typedNode.(Bag)
+		if reference, ok := typedNode.(*Bag); ok {
+			target = *reference
+		}
+
+		child, ok := target[part.key]
+		if part.bracket || !ok {
+			return nil, newErrBagInvalidPath(path)
+		}
+
+		if len(rest) == 0 {
+			delete(target, part.key)
+			return node, nil
+		}
+
+		child, e := bagDelete(child, rest, path, prune)
+		if e != nil {
+			return nil, e
+		}
+
+		if prune && bagEmpty(child) {
+			delete(target, part.key)
+		} else {
+			target[part.key] = child
+		}
+
+		return node, nil
+	case []any:
+		index, ok := bagIndex(typedNode, part.key)
+		if part.literal || !ok {
+			return nil, newErrBagInvalidPath(path)
+		}
+
+		if len(rest) == 0 {
+			return slices.Concat(typedNode[:index], typedNode[index+1:]), nil
+		}
+
+		child, e := bagDelete(typedNode[index], rest, path, prune)
+		if e != nil {
+			return nil, e
+		}
+		typedNode[index] = child
+
+		return typedNode, nil
+	default:
+		return nil, newErrBagInvalidPath(path)
+	}
+}
+
+func bagEmpty(
+	value any,
+) bool {
+	switch typedValue := value.(type) {
+	case Bag:
+		return len(typedValue) == 0
+	case *Bag:
+		return len(*typedValue) == 0
+	default:
+		return false
+	}
+}
+
+func bagClone(
+	value any,
+) any {
+	switch typedValue := value.(type) {
+	case []any:
+		var result []any
+		for _, i := range typedValue {
+			result = append(result, bagClone(i))
+		}
+		return result
+	case Bag:
+		return typedValue.Clone()
+	case *Bag:
+		return typedValue.Clone()
+	default:
+		return value
+	}
+}
@@ -0,0 +1,13 @@
+package flam
+
+import (
+	"flag"
+)
+
+type Command struct {
+	Name        string
+	Description string
+	Requires    []string
+	Flags       func(flags *flag.FlagSet)
+	Handler     CommandHandler
+}
@@ -0,0 +1,9 @@
+package flam
+
+import (
+	"flag"
+
+	"go.uber.org/dig"
+)
+
+type CommandHandler func(container *dig.Container, flags *flag.FlagSet) error
@@ -0,0 +1,34 @@
+package flam
+
+import (
+	"runtime"
+	"sync"
+
+	"go.uber.org/dig"
+)
+
+var containerLockers = sync.Map{}
+
+func lockContainer(
+	app *application,
+	container *dig.Container,
+	locker sync.Locker,
+) {
+	containerLockers.Store(container, locker)
+	runtime.AddCleanup(app, func(container *dig.Container) {
+		containerLockers.Delete(container)
+	}, container)
+}
+
+func Invoke(
+	container *dig.Container,
+	function any,
+	opts ...dig.InvokeOption,
+) error {
+	if locker, ok := containerLockers.Load(container); ok {
+		locker.(sync.Locker).Lock()
+		defer locker.(sync.Locker).Unlock()
+	}
+
+	return container.Invoke(function, opts...)
+}
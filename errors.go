@@ -3,18 +3,47 @@ package flam
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"strings"
+	"time"
 )
 
 var (
 	ErrNilReference = errors.New("nil reference")
 
-	ErrBagInvalidPath = errors.New("invalid bag path")
+	ErrBagInvalidPath     = errors.New("invalid bag path")
+	ErrBagValueConversion = errors.New("invalid bag value conversion")
+	ErrBagValueOverflow   = errors.New("bag value overflow")
 
 	ErrUnknownResource       = errors.New("unknown resource")
 	ErrInvalidResourceConfig = errors.New("invalid resource config")
 	ErrDuplicateResource     = errors.New("duplicate resource")
 
-	ErrDuplicateProvider = errors.New("duplicate provider")
+	ErrDuplicateProvider         = errors.New("duplicate provider")
+	ErrUnknownProviderDependency = errors.New("unknown provider dependency")
+	ErrProviderDependencyCycle   = errors.New("provider dependency cycle")
+	ErrUnknownProvider           = errors.New("unknown provider")
+	ErrProviderIdMismatch        = errors.New("provider id mismatch")
+	ErrProviderNotReloadable     = errors.New("provider not reloadable")
+	ErrProviderHasDependents     = errors.New("provider has dependents")
+	ErrUnexpectedModule          = errors.New("unexpected module")
+
+	ErrUnknownCommand          = errors.New("unknown command")
+	ErrDuplicateCommand        = errors.New("duplicate command")
+	ErrInvalidCommandArguments = errors.New("invalid command arguments")
+
+	ErrInvalidApplicationState = errors.New("invalid application state")
+	ErrShutdownTimeout         = errors.New("shutdown grace period exceeded")
+	ErrProviderTimeout         = errors.New("provider timeout")
+
+	ErrInvalidConstructor = errors.New("invalid constructor")
+	ErrInvalidGraph       = errors.New("invalid dependency graph")
+
+	ErrDuplicateWorker      = errors.New("duplicate worker")
+	ErrRestartLimitExceeded = errors.New("restart limit exceeded")
+
+	ErrPidLockHeld        = errors.New("pid lock already held")
+	ErrPidLockUnsupported = errors.New("pid lock not supported")
 )
 
 func newErrNilReference(
@@ -33,6 +62,18 @@ func newErrBagInvalidPath(
 		path)
 }
 
+func newErrBagConversion(
+	e error,
+	path string,
+	value any,
+	target string,
+) error {
+	return NewErrorFrom(
+		e,
+		fmt.Sprintf("%s: %T(%v) to %s", path, value, value, target),
+		Bag{"path": path, "type": target})
+}
+
 func newErrUnknownResource(
 	resource string,
 	id string,
@@ -60,6 +101,26 @@ func newErrDuplicateResource(
 		id)
 }
 
+func newErrResourceClose(
+	id string,
+	e error,
+) error {
+	return NewErrorFrom(
+		e,
+		fmt.Sprintf("resource(%s)", id),
+		Bag{"resource": id})
+}
+
+func newErrResourceHealth(
+	id string,
+	e error,
+) error {
+	return NewErrorFrom(
+		e,
+		fmt.Sprintf("resource(%s)", id),
+		Bag{"resource": id})
+}
+
 func newErrDuplicateProvider(
 	id string,
 ) error {
@@ -67,3 +128,239 @@ func newErrDuplicateProvider(
 		ErrDuplicateProvider,
 		id)
 }
+
+func newErrProviderClose(
+	id string,
+	e error,
+) error {
+	return NewErrorFrom(
+		e,
+		fmt.Sprintf("provider(%s)", id),
+		Bag{"provider": id})
+}
+
+func newErrDuplicateModuleProvider(
+	id string,
+	first string,
+	second string,
+) error {
+	return NewErrorFrom(
+		ErrDuplicateProvider,
+		fmt.Sprintf("%s registered as %s and %s", id, first, second),
+		Bag{"provider": id, "paths": []string{first, second}})
+}
+
+func newErrModuleRegister(
+	path string,
+	registered []string,
+	e error,
+) error {
+	return NewErrorFrom(
+		e,
+		fmt.Sprintf("provider(%s)", path),
+		Bag{"provider": path, "registered": registered})
+}
+
+func newErrUnknownProvider(
+	id string,
+) error {
+	return NewErrorFrom(
+		ErrUnknownProvider,
+		id,
+		Bag{"provider": id})
+}
+
+func newErrProviderIdMismatch(
+	id string,
+	given string,
+) error {
+	return NewErrorFrom(
+		ErrProviderIdMismatch,
+		fmt.Sprintf("%s != %s", given, id),
+		Bag{"provider": id, "given": given})
+}
+
+func newErrProviderNotReloadable(
+	id string,
+) error {
+	return NewErrorFrom(
+		ErrProviderNotReloadable,
+		id,
+		Bag{"provider": id})
+}
+
+func newErrInvalidGraph(
+	reason string,
+) error {
+	return NewErrorFrom(
+		ErrInvalidGraph,
+		reason)
+}
+
+func newErrUnexpectedModule(
+	namespace string,
+) error {
+	return NewErrorFrom(
+		ErrUnexpectedModule,
+		namespace,
+		Bag{"module": namespace})
+}
+
+func newErrProviderHasDependents(
+	id string,
+	dependents []string,
+) error {
+	return NewErrorFrom(
+		ErrProviderHasDependents,
+		fmt.Sprintf("%s <- %s", id, strings.Join(dependents, ", ")),
+		Bag{"provider": id, "dependents": dependents})
+}
+
+func newErrUnknownProviderDependency(
+	id string,
+	dependency string,
+) error {
+	return NewErrorFrom(
+		ErrUnknownProviderDependency,
+		fmt.Sprintf("%s -> %s", id, dependency),
+		Bag{"provider": id, "dependency": dependency})
+}
+
+func newErrProviderDependencyCycle(
+	cycle []string,
+) error {
+	return NewErrorFrom(
+		ErrProviderDependencyCycle,
+		strings.Join(cycle, " -> "),
+		Bag{"cycle": cycle})
+}
+
+func newErrUnknownCommand(
+	command string,
+) error {
+	return NewErrorFrom(
+		ErrUnknownCommand,
+		command,
+		Bag{"command": command}).
+		SetCode(ExitUsage)
+}
+
+func newErrDuplicateCommand(
+	command string,
+	first string,
+	second string,
+) error {
+	return NewErrorFrom(
+		ErrDuplicateCommand,
+		fmt.Sprintf("%s provided by %s and %s", command, first, second),
+		Bag{"command": command, "providers": []string{first, second}})
+}
+
+func newErrInvalidCommandArguments(
+	command string,
+	e error,
+) error {
+	return NewErrorFrom(
+		ErrInvalidCommandArguments,
+		fmt.Sprintf("%s: %v", command, e),
+		Bag{"command": command}).
+		SetCode(ExitUsage)
+}
+
+func newErrInvalidApplicationState(
+	operation string,
+	state ApplicationState,
+) error {
+	return NewErrorFrom(
+		ErrInvalidApplicationState,
+		fmt.Sprintf("%s on %s application", operation, state),
+		Bag{"operation": operation, "state": state.String()})
+}
+
+func newErrShutdownTimeout(
+	period time.Duration,
+) error {
+	return NewErrorFrom(
+		ErrShutdownTimeout,
+		period.String())
+}
+
+func newErrProviderTimeout(
+	id string,
+	phase string,
+	timeout time.Duration,
+) error {
+	return NewErrorFrom(
+		ErrProviderTimeout,
+		fmt.Sprintf("%s(%s) after %s", id, phase, timeout),
+		Bag{"provider": id, "phase": phase, "timeout": timeout})
+}
+
+func newErrDuplicateWorker(
+	id string,
+) error {
+	return NewErrorFrom(
+		ErrDuplicateWorker,
+		id,
+		Bag{"worker": id})
+}
+
+func newErrRestartLimitExceeded(
+	id string,
+	restarts int,
+) error {
+	return NewErrorFrom(
+		ErrRestartLimitExceeded,
+		fmt.Sprintf("%s after %d restarts", id, restarts),
+		Bag{"worker": id, "restarts": restarts})
+}
+
+func newErrPidLockHeld(
+	path string,
+	pid int,
+) error {
+	return NewErrorFrom(
+		ErrPidLockHeld,
+		fmt.Sprintf("%s (pid %d)", path, pid),
+		Bag{"path": path, "pid": pid})
+}
+
+func newErrPidLockUnsupported(
+	path string,
+) error {
+	return NewErrorFrom(
+		ErrPidLockUnsupported,
+		path,
+		Bag{"path": path})
+}
+
+func newErrResolve(
+	valueType reflect.Type,
+	name string,
+	e error,
+) error {
+	return NewErrorFrom(
+		e,
+		fmt.Sprintf("resolve(%s)", valueType),
+		Bag{"type": valueType.String(), "name": name})
+}
+
+func newErrInvalidConstructor(
+	valueType reflect.Type,
+	constructorType reflect.Type,
+) error {
+	return NewErrorFrom(
+		ErrInvalidConstructor,
+		fmt.Sprintf("%v does not provide %s", constructorType, valueType),
+		Bag{"type": valueType.String()})
+}
+
+func newErrProvide(
+	valueType reflect.Type,
+	e error,
+) error {
+	return NewErrorFrom(
+		e,
+		fmt.Sprintf("provide(%s)", valueType),
+		Bag{"type": valueType.String()})
+}
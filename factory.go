@@ -1,7 +1,10 @@
 package flam
 
 import (
+	"context"
+	"errors"
 	"io"
+	"maps"
 	"reflect"
 	"slices"
 	"strings"
@@ -15,6 +18,7 @@ type Factory[R Resource] interface {
 	Get(id string) (R, error)
 	Generate(id string) (R, error)
 	Add(id string, value R) error
+	HealthChecks() []HealthCheck
 }
 
 type factory[R Resource] struct {
@@ -50,15 +54,40 @@ func (factory *factory[R]) Close() error {
 	factory.locker.Lock()
 	defer factory.locker.Unlock()
 
-	for _, entry := range factory.entries {
-		if closer, ok := any(entry).(io.Closer); ok {
+	ids := slices.Sorted(maps.Keys(factory.entries))
+
+	var errs []error
+	for _, id := range ids {
+		if closer, ok := any(factory.entries[id]).(io.Closer); ok {
 			if e := closer.Close(); e != nil {
-				return e
+				errs = append(errs, newErrResourceClose(id, e))
 			}
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
+}
+
+func (factory *factory[R]) HealthChecks() []HealthCheck {
+	factory.locker.Lock()
+	defer factory.locker.Unlock()
+
+	var checks []HealthCheck
+	for _, id := range slices.Sorted(maps.Keys(factory.entries)) {
+		if checker, ok := any(factory.entries[id]).(HealthChecker); ok {
+			checks = append(checks, HealthCheck{
+				Name: id,
+				Check: func(ctx context.Context) error {
+					if e := checker.Check(ctx); e != nil {
+						return newErrResourceHealth(id, e)
+					}
+					return nil
+				},
+			})
+		}
+	}
+
+	return checks
 }
 
 func (factory *factory[R]) List() []string {
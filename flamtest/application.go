@@ -0,0 +1,43 @@
+package flamtest
+
+import (
+	"testing"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+func NewTestApplication(
+	t testing.TB,
+	providers ...flam.Provider,
+) flam.Application {
+	t.Helper()
+
+	app := PrepareTestApplication(t, providers...)
+	if e := app.Boot(); e != nil {
+		t.Fatalf("booting test application: %v", e)
+	}
+
+	return app
+}
+
+func PrepareTestApplication(
+	t testing.TB,
+	providers ...flam.Provider,
+) flam.Application {
+	t.Helper()
+
+	app := flam.NewApplication()
+	t.Cleanup(func() {
+		if e := app.Close(); e != nil {
+			t.Errorf("closing test application: %v", e)
+		}
+	})
+
+	for _, provider := range providers {
+		if e := app.Register(provider); e != nil {
+			t.Fatalf("registering test provider: %v", e)
+		}
+	}
+
+	return app
+}
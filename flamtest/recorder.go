@@ -0,0 +1,65 @@
+package flamtest
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+type Recorder struct {
+	locker  sync.Locker
+	entries []string
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{
+		locker:  &sync.Mutex{},
+		entries: []string{},
+	}
+}
+
+func (recorder *Recorder) Provider(
+	id string,
+	dependencies ...string,
+) flam.Provider {
+	return &recordingProvider{
+		id:           id,
+		dependencies: dependencies,
+		recorder:     recorder,
+	}
+}
+
+func (recorder *Recorder) Entries() []string {
+	recorder.locker.Lock()
+	defer recorder.locker.Unlock()
+
+	return slices.Clone(recorder.entries)
+}
+
+func (recorder *Recorder) AssertOrder(
+	t testing.TB,
+	expected ...string,
+) bool {
+	t.Helper()
+
+	entries := recorder.Entries()
+	if !slices.Equal(expected, entries) {
+		t.Errorf("unexpected lifecycle order\nexpected: %v\nactual:   %v", expected, entries)
+		return false
+	}
+
+	return true
+}
+
+func (recorder *Recorder) record(
+	phase string,
+	id string,
+) {
+	recorder.locker.Lock()
+	defer recorder.locker.Unlock()
+
+	recorder.entries = append(recorder.entries, fmt.Sprintf("%s:%s", phase, id))
+}
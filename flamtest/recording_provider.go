@@ -0,0 +1,51 @@
+package flamtest
+
+import (
+	"go.uber.org/dig"
+)
+
+type recordingProvider struct {
+	id           string
+	dependencies []string
+	recorder     *Recorder
+}
+
+func (provider *recordingProvider) Id() string {
+	return provider.id
+}
+
+func (provider *recordingProvider) DependsOn() []string {
+	return provider.dependencies
+}
+
+func (provider *recordingProvider) Register(
+	_ *dig.Container,
+) error {
+	provider.recorder.record("register", provider.id)
+
+	return nil
+}
+
+func (provider *recordingProvider) Boot(
+	_ *dig.Container,
+) error {
+	provider.recorder.record("boot", provider.id)
+
+	return nil
+}
+
+func (provider *recordingProvider) Run(
+	_ *dig.Container,
+) error {
+	provider.recorder.record("run", provider.id)
+
+	return nil
+}
+
+func (provider *recordingProvider) Close(
+	_ *dig.Container,
+) error {
+	provider.recorder.record("close", provider.id)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package flamtest
+
+import (
+	"reflect"
+	"sync"
+
+	"go.uber.org/dig"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+type replacement[T any] struct {
+	value T
+}
+
+var (
+	replacementsLocker = sync.Mutex{}
+	replacements       = map[*dig.Container]map[reflect.Type]any{}
+)
+
+func Replace[T any](
+	app flam.Application,
+	value T,
+) error {
+	if app == nil {
+		return flam.NewErrorFrom(flam.ErrNilReference, "app")
+	}
+
+	replacementsLocker.Lock()
+	defer replacementsLocker.Unlock()
+
+	container := app.Container()
+	valueType := reflect.TypeFor[T]()
+	if replaced, ok := replacements[container][valueType]; ok {
+		replaced.(*replacement[T]).value = value
+		return nil
+	}
+
+	replaced := &replacement[T]{value: value}
+	constructor := func() T {
+		return replaced.value
+	}
+
+	if e := dig.New().Provide(constructor); e != nil {
+		return e
+	}
+
+	if e := container.Provide(constructor); e != nil {
+		if e := container.Decorate(constructor); e != nil {
+			return e
+		}
+	}
+
+	if replacements[container] == nil {
+		replacements[container] = map[reflect.Type]any{}
+	}
+	replacements[container][valueType] = replaced
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package flam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.uber.org/dig"
+)
+
+type Graph struct {
+	Constructors []GraphConstructor `json:"constructors"`
+}
+
+type GraphConstructor struct {
+	Provider string   `json:"provider"`
+	Package  string   `json:"package"`
+	Name     string   `json:"name"`
+	Params   []string `json:"params"`
+	Results  []string `json:"results"`
+}
+
+func (graph Graph) JSON() ([]byte, error) {
+	return json.MarshalIndent(graph, "", "  ")
+}
+
+func (graph Graph) DOT() string {
+	providers := []string{}
+	constructors := map[string][]int{}
+	for i, constructor := range graph.Constructors {
+		if _, ok := constructors[constructor.Provider]; !ok {
+			providers = append(providers, constructor.Provider)
+		}
+		constructors[constructor.Provider] = append(constructors[constructor.Provider], i)
+	}
+
+	b := &strings.Builder{}
+	b.WriteString("digraph {\n\trankdir=RL;\n")
+	for i, provider := range providers {
+		_, _ = fmt.Fprintf(b, "\tsubgraph cluster_%d {\n", i)
+		_, _ = fmt.Fprintf(b, "\t\tlabel = %s;\n", strconv.Quote(provider))
+		for _, index := range constructors[provider] {
+			constructor := graph.Constructors[index]
+			_, _ = fmt.Fprintf(b, "\t\tconstructor_%d [shape=box label=%s];\n", index, strconv.Quote(constructor.Package+"."+constructor.Name))
+		}
+		b.WriteString("\t}\n")
+	}
+
+	for i, constructor := range graph.Constructors {
+		for _, result := range constructor.Results {
+			_, _ = fmt.Fprintf(b, "\tconstructor_%d -> %s;\n", i, strconv.Quote(result))
+		}
+		for _, param := range constructor.Params {
+			_, _ = fmt.Fprintf(b, "\t%s -> constructor_%d [style=dashed];\n", strconv.Quote(param), i)
+		}
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func (constructor GraphConstructor) key() string {
+	return constructor.Package + "." + constructor.Name + "(" + strings.Join(constructor.Results, ",") + ")"
+}
+
+var (
+	graphClusterRegex     = regexp.MustCompile(`^\tsubgraph cluster_\d+ \{$`)
+	graphPackageRegex     = regexp.MustCompile(`^\t\tlabel = ("(?:[^"\\]|\\.)*");$`)
+	graphConstructorRegex = regexp.MustCompile(`^\t\tconstructor_\d+ \[shape=plaintext label=("(?:[^"\\]|\\.)*")\];$`)
+	graphResultRegex      = regexp.MustCompile(`^\t\t("(?:[^"\\]|\\.)*") \[`)
+	graphParamRegex       = regexp.MustCompile(`^\tconstructor_(\d+) -> ("(?:[^"\\]|\\.)*") \[`)
+)
+
+func graphConstructors(
+	container *dig.Container,
+) ([]GraphConstructor, error) {
+	buffer := &bytes.Buffer{}
+	if e := dig.Visualize(container, buffer); e != nil {
+		return nil, e
+	}
+
+	lines := strings.Split(buffer.String(), "\n")
+	if lines[0] != "digraph {" {
+		return nil, newErrInvalidGraph("unrecognized visualization header")
+	}
+
+	var constructors []GraphConstructor
+	var current *GraphConstructor
+	for _, line := range lines[1:] {
+		switch {
+		case graphClusterRegex.MatchString(line):
+			constructors = append(constructors, GraphConstructor{Params: []string{}, Results: []string{}})
+			current = &constructors[len(constructors)-1]
+		case line == "\t}":
+			if current != nil && current.Name == "" {
+				return nil, newErrInvalidGraph("unrecognized constructor cluster")
+			}
+			current = nil
+		case current != nil:
+			if match := graphPackageRegex.FindStringSubmatch(line); match != nil {
+				current.Package, _ = strconv.Unquote(match[1])
+			} else if match := graphConstructorRegex.FindStringSubmatch(line); match != nil {
+				current.Name, _ = strconv.Unquote(match[1])
+			} else if match := graphResultRegex.FindStringSubmatch(line); match != nil {
+				result, _ := strconv.Unquote(match[1])
+				current.Results = append(current.Results, result)
+			}
+		default:
+			if match := graphParamRegex.FindStringSubmatch(line); match != nil {
+				index, _ := strconv.Atoi(match[1])
+				if index < len(constructors) {
+					param, _ := strconv.Unquote(match[2])
+					constructors[index].Params = append(constructors[index].Params, param)
+				}
+			}
+		}
+	}
+
+	return constructors, nil
+}
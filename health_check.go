@@ -0,0 +1,10 @@
+package flam
+
+import (
+	"context"
+)
+
+type HealthCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
@@ -0,0 +1,9 @@
+package flam
+
+import (
+	"context"
+)
+
+type HealthChecker interface {
+	Check(ctx context.Context) error
+}
@@ -0,0 +1,12 @@
+package flam
+
+import (
+	"time"
+)
+
+type HealthComponent struct {
+	Name    string        `json:"name"`
+	Status  HealthStatus  `json:"status"`
+	Latency time.Duration `json:"latency"`
+	Error   string        `json:"error,omitempty"`
+}
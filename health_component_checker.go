@@ -0,0 +1,5 @@
+package flam
+
+type ComponentHealthChecker interface {
+	HealthChecks() []HealthCheck
+}
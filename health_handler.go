@@ -0,0 +1,28 @@
+package flam
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func NewHealthHandler(
+	app Application,
+	probe HealthProbe,
+) (http.Handler, error) {
+	if app == nil {
+		return nil, newErrNilReference("app")
+	}
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		report := app.Health(request.Context(), probe)
+
+		status := http.StatusOK
+		if report.Status != HealthUp {
+			status = http.StatusServiceUnavailable
+		}
+
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(status)
+		_ = json.NewEncoder(writer).Encode(report)
+	}), nil
+}
@@ -0,0 +1,9 @@
+package flam
+
+import (
+	"context"
+)
+
+type LivenessChecker interface {
+	CheckLiveness(ctx context.Context) error
+}
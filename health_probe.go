@@ -0,0 +1,19 @@
+package flam
+
+type HealthProbe int
+
+const (
+	HealthLiveness HealthProbe = iota
+	HealthReadiness
+)
+
+func (probe HealthProbe) String() string {
+	switch probe {
+	case HealthLiveness:
+		return "liveness"
+	case HealthReadiness:
+		return "readiness"
+	default:
+		return "unknown"
+	}
+}
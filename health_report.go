@@ -0,0 +1,7 @@
+package flam
+
+type HealthReport struct {
+	Probe      string            `json:"probe"`
+	Status     HealthStatus      `json:"status"`
+	Components []HealthComponent `json:"components"`
+}
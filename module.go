@@ -0,0 +1,87 @@
+package flam
+
+import (
+	"go.uber.org/dig"
+)
+
+type Module struct {
+	namespace string
+	providers []Provider
+}
+
+var _ Provider = &Module{}
+
+func NewModule(
+	namespace string,
+	providers ...Provider,
+) *Module {
+	return &Module{
+		namespace: namespace,
+		providers: providers,
+	}
+}
+
+func (module *Module) Id() string {
+	return module.namespace
+}
+
+func (module *Module) Register(
+	_ *dig.Container,
+) error {
+	return newErrUnexpectedModule(module.namespace)
+}
+
+func (module *Module) Providers() []Provider {
+	return module.providers
+}
+
+type moduleEntry struct {
+	provider Provider
+	module   string
+}
+
+func (entry moduleEntry) path() string {
+	if entry.module == "" {
+		return entry.provider.Id()
+	}
+
+	return entry.module + "/" + entry.provider.Id()
+}
+
+func flattenProvider(
+	provider Provider,
+	parent string,
+) ([]moduleEntry, error) {
+	module, ok := provider.(*Module)
+	if !ok {
+		return []moduleEntry{{provider: provider, module: parent}}, nil
+	}
+
+	path := module.namespace
+	if parent != "" {
+		path = parent + "/" + module.namespace
+	}
+
+	var entries []moduleEntry
+	for _, child := range module.providers {
+		if child == nil {
+			return nil, newErrNilReference(path + "/provider")
+		}
+
+		childEntries, e := flattenProvider(child, path)
+		if e != nil {
+			return nil, e
+		}
+
+		for _, childEntry := range childEntries {
+			for _, entry := range entries {
+				if entry.provider.Id() == childEntry.provider.Id() {
+					return nil, newErrDuplicateModuleProvider(childEntry.provider.Id(), entry.path(), childEntry.path())
+				}
+			}
+			entries = append(entries, childEntry)
+		}
+	}
+
+	return entries, nil
+}
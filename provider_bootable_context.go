@@ -0,0 +1,11 @@
+package flam
+
+import (
+	"context"
+
+	"go.uber.org/dig"
+)
+
+type BootableContextProvider interface {
+	BootContext(ctx context.Context, container *dig.Container) error
+}
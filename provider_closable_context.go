@@ -0,0 +1,11 @@
+package flam
+
+import (
+	"context"
+
+	"go.uber.org/dig"
+)
+
+type ClosableContextProvider interface {
+	CloseContext(ctx context.Context, container *dig.Container) error
+}
@@ -0,0 +1,5 @@
+package flam
+
+type CommandProvider interface {
+	Commands() []Command
+}
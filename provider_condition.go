@@ -0,0 +1,19 @@
+package flam
+
+import (
+	"reflect"
+
+	"go.uber.org/dig"
+)
+
+type ProviderCondition func(container *dig.Container) (bool, error)
+
+func BagCondition(
+	config Bag,
+	path string,
+	expected any,
+) ProviderCondition {
+	return func(*dig.Container) (bool, error) {
+		return reflect.DeepEqual(config.Get(path), expected), nil
+	}
+}
@@ -0,0 +1,62 @@
+package flam
+
+import (
+	"go.uber.org/dig"
+)
+
+type ConditionalProvider struct {
+	provider  Provider
+	condition ProviderCondition
+}
+
+var _ Provider = &ConditionalProvider{}
+var _ DependentProvider = &ConditionalProvider{}
+
+func NewConditionalProvider(
+	provider Provider,
+	condition ProviderCondition,
+) (*ConditionalProvider, error) {
+	switch {
+	case provider == nil:
+		return nil, newErrNilReference("provider")
+	case condition == nil:
+		return nil, newErrNilReference("condition")
+	}
+
+	if module, ok := provider.(*Module); ok {
+		return nil, newErrUnexpectedModule(module.namespace)
+	}
+
+	return &ConditionalProvider{
+		provider:  provider,
+		condition: condition,
+	}, nil
+}
+
+func (conditional *ConditionalProvider) Id() string {
+	return conditional.provider.Id()
+}
+
+func (conditional *ConditionalProvider) Register(
+	_ *dig.Container,
+) error {
+	return nil
+}
+
+func (conditional *ConditionalProvider) DependsOn() []string {
+	if dependent, ok := conditional.provider.(DependentProvider); ok {
+		return dependent.DependsOn()
+	}
+
+	return nil
+}
+
+func (conditional *ConditionalProvider) Provider() Provider {
+	return conditional.provider
+}
+
+func (conditional *ConditionalProvider) Evaluate(
+	container *dig.Container,
+) (bool, error) {
+	return conditional.condition(container)
+}
@@ -0,0 +1,5 @@
+package flam
+
+type DependentProvider interface {
+	DependsOn() []string
+}
@@ -0,0 +1,110 @@
+package flam
+
+import (
+	"slices"
+)
+
+const (
+	providerUnvisited = iota
+	providerVisiting
+	providerVisited
+)
+
+func sortProviders(
+	providers []Provider,
+) ([]Provider, error) {
+	index := map[string]Provider{}
+	for _, provider := range providers {
+		index[provider.Id()] = provider
+	}
+
+	marks := map[string]int{}
+	path := []string{}
+	sorted := make([]Provider, 0, len(providers))
+
+	var visit func(provider Provider) error
+	visit = func(provider Provider) error {
+		id := provider.Id()
+		switch marks[id] {
+		case providerVisited:
+			return nil
+		case providerVisiting:
+			cycle := slices.Clone(path[slices.Index(path, id):])
+			return newErrProviderDependencyCycle(append(cycle, id))
+		}
+
+		marks[id] = providerVisiting
+		path = append(path, id)
+
+		if dependent, ok := provider.(DependentProvider); ok {
+			for _, dependency := range dependent.DependsOn() {
+				next, ok := index[dependency]
+				if !ok {
+					return newErrUnknownProviderDependency(id, dependency)
+				}
+
+				if e := visit(next); e != nil {
+					return e
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		marks[id] = providerVisited
+		sorted = append(sorted, provider)
+
+		return nil
+	}
+
+	for _, provider := range providers {
+		if e := visit(provider); e != nil {
+			return nil, e
+		}
+	}
+
+	return sorted, nil
+}
+
+func requiredProviders(
+	sorted []Provider,
+	ids []string,
+) ([]Provider, error) {
+	index := map[string]Provider{}
+	for _, provider := range sorted {
+		index[provider.Id()] = provider
+	}
+
+	required := map[string]bool{}
+	var require func(id string) error
+	require = func(id string) error {
+		if required[id] {
+			return nil
+		}
+
+		provider, ok := index[id]
+		if !ok {
+			return newErrUnknownProvider(id)
+		}
+		required[id] = true
+
+		if dependent, ok := provider.(DependentProvider); ok {
+			for _, dependency := range dependent.DependsOn() {
+				if e := require(dependency); e != nil {
+					return e
+				}
+			}
+		}
+
+		return nil
+	}
+
+	for _, id := range ids {
+		if e := require(id); e != nil {
+			return nil, e
+		}
+	}
+
+	return slices.DeleteFunc(slices.Clone(sorted), func(provider Provider) bool {
+		return !required[provider.Id()]
+	}), nil
+}
@@ -0,0 +1,58 @@
+package flam
+
+import (
+	"slices"
+	"time"
+)
+
+type ProviderInfo struct {
+	Id           string
+	Module       string
+	Interfaces   []string
+	State        ProviderState
+	BootDuration time.Duration
+}
+
+type providerRecord struct {
+	module           string
+	state            ProviderState
+	registerDuration time.Duration
+	bootDuration     time.Duration
+	runStart         time.Duration
+	closeDuration    time.Duration
+}
+
+func providerInterfaces(
+	provider Provider,
+) []string {
+	var interfaces []string
+	add := func(name string, implemented ...bool) {
+		if slices.Contains(implemented, true) {
+			interfaces = append(interfaces, name)
+		}
+	}
+
+	_, dependent := provider.(DependentProvider)
+	_, bootable := provider.(BootableProvider)
+	_, bootableContext := provider.(BootableContextProvider)
+	_, runnable := provider.(RunnableProvider)
+	_, runnableContext := provider.(RunnableContextProvider)
+	_, closable := provider.(ClosableProvider)
+	_, closableContext := provider.(ClosableContextProvider)
+	_, scoped := provider.(ScopedProvider)
+	_, health := provider.(HealthChecker)
+	_, components := provider.(ComponentHealthChecker)
+	_, liveness := provider.(LivenessChecker)
+	_, conditional := provider.(*ConditionalProvider)
+
+	add("dependent", dependent)
+	add("bootable", bootable, bootableContext)
+	add("runnable", runnable, runnableContext)
+	add("closable", closable, closableContext)
+	add("scoped", scoped)
+	add("health", health, components)
+	add("liveness", liveness)
+	add("conditional", conditional)
+
+	return interfaces
+}
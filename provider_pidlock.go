@@ -0,0 +1,74 @@
+package flam
+
+import (
+	"os"
+	"sync"
+
+	"go.uber.org/dig"
+)
+
+const PidLockProviderId = "flam.pidlock"
+
+type PidLockProvider struct {
+	locker sync.Locker
+	path   string
+	file   *os.File
+}
+
+var _ Provider = &PidLockProvider{}
+var _ BootableProvider = &PidLockProvider{}
+var _ ClosableProvider = &PidLockProvider{}
+
+func NewPidLockProvider(
+	path string,
+) *PidLockProvider {
+	return &PidLockProvider{
+		locker: &sync.Mutex{},
+		path:   path,
+	}
+}
+
+func (provider *PidLockProvider) Id() string {
+	return PidLockProviderId
+}
+
+func (provider *PidLockProvider) Register(
+	_ *dig.Container,
+) error {
+	return nil
+}
+
+func (provider *PidLockProvider) Boot(
+	_ *dig.Container,
+) error {
+	provider.locker.Lock()
+	defer provider.locker.Unlock()
+
+	if provider.file != nil {
+		return nil
+	}
+
+	file, e := lockPidFile(provider.path)
+	if e != nil {
+		return e
+	}
+	provider.file = file
+
+	return nil
+}
+
+func (provider *PidLockProvider) Close(
+	_ *dig.Container,
+) error {
+	provider.locker.Lock()
+	defer provider.locker.Unlock()
+
+	if provider.file == nil {
+		return nil
+	}
+
+	e := unlockPidFile(provider.file)
+	provider.file = nil
+
+	return e
+}
@@ -0,0 +1,19 @@
+//go:build !(linux || darwin || freebsd || openbsd || netbsd || dragonfly)
+
+package flam
+
+import (
+	"os"
+)
+
+func lockPidFile(
+	path string,
+) (*os.File, error) {
+	return nil, newErrPidLockUnsupported(path)
+}
+
+func unlockPidFile(
+	file *os.File,
+) error {
+	return file.Close()
+}
@@ -0,0 +1,53 @@
+//go:build linux || darwin || freebsd || openbsd || netbsd || dragonfly
+
+package flam
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+func lockPidFile(
+	path string,
+) (*os.File, error) {
+	file, e := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if e != nil {
+		return nil, e
+	}
+
+	if e := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); e != nil {
+		content, _ := io.ReadAll(file)
+		_ = file.Close()
+
+		if errors.Is(e, syscall.EWOULDBLOCK) {
+			pid, _ := strconv.Atoi(strings.TrimSpace(string(content)))
+			return nil, newErrPidLockHeld(path, pid)
+		}
+		return nil, e
+	}
+
+	if e := file.Truncate(0); e != nil {
+		_ = unlockPidFile(file)
+		return nil, e
+	}
+
+	if _, e := file.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0); e != nil {
+		_ = unlockPidFile(file)
+		return nil, e
+	}
+
+	return file, nil
+}
+
+func unlockPidFile(
+	file *os.File,
+) error {
+	return errors.Join(
+		file.Truncate(0),
+		syscall.Flock(int(file.Fd()), syscall.LOCK_UN),
+		file.Close())
+}
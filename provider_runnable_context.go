@@ -0,0 +1,11 @@
+package flam
+
+import (
+	"context"
+
+	"go.uber.org/dig"
+)
+
+type RunnableContextProvider interface {
+	RunContext(ctx context.Context, container *dig.Container) error
+}
@@ -0,0 +1,9 @@
+package flam
+
+import (
+	"go.uber.org/dig"
+)
+
+type ScopedProvider interface {
+	RegisterScope(scope *dig.Scope) error
+}
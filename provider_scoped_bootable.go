@@ -0,0 +1,9 @@
+package flam
+
+import (
+	"go.uber.org/dig"
+)
+
+type BootableScopedProvider interface {
+	BootScope(scope *dig.Scope) error
+}
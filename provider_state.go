@@ -0,0 +1,28 @@
+package flam
+
+type ProviderState int
+
+const (
+	ProviderRegistered ProviderState = iota
+	ProviderBooted
+	ProviderFailed
+	ProviderClosed
+	ProviderSkipped
+)
+
+func (state ProviderState) String() string {
+	switch state {
+	case ProviderRegistered:
+		return "registered"
+	case ProviderBooted:
+		return "booted"
+	case ProviderFailed:
+		return "failed"
+	case ProviderClosed:
+		return "closed"
+	case ProviderSkipped:
+		return "skipped"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,5 @@
+package flam
+
+type SupervisedProvider interface {
+	RestartPolicy() RestartPolicy
+}
@@ -0,0 +1,98 @@
+package flam
+
+import (
+	"reflect"
+	"strconv"
+
+	"go.uber.org/dig"
+)
+
+func Resolve[T any](
+	app Application,
+) (T, error) {
+	var value T
+	if app == nil {
+		return value, newErrNilReference("app")
+	}
+
+	if e := app.Scope().Invoke(func(resolved T) {
+		value = resolved
+	}); e != nil {
+		return value, newErrResolve(reflect.TypeFor[T](), "", e)
+	}
+
+	return value, nil
+}
+
+func MustResolve[T any](
+	app Application,
+) T {
+	value, e := Resolve[T](app)
+	if e != nil {
+		panic(e)
+	}
+
+	return value
+}
+
+func ResolveNamed[T any](
+	app Application,
+	name string,
+) (T, error) {
+	var value T
+	if app == nil {
+		return value, newErrNilReference("app")
+	}
+
+	valueType := reflect.TypeFor[T]()
+	paramType := reflect.StructOf([]reflect.StructField{
+		{
+			Name:      "In",
+			Type:      reflect.TypeFor[dig.In](),
+			Anonymous: true,
+		},
+		{
+			Name: "Value",
+			Type: valueType,
+			Tag:  reflect.StructTag("name:" + strconv.Quote(name)),
+		},
+	})
+
+	invoker := reflect.MakeFunc(
+		reflect.FuncOf([]reflect.Type{paramType}, nil, false),
+		func(args []reflect.Value) []reflect.Value {
+			value = args[0].Field(1).Interface().(T)
+			return nil
+		})
+
+	if e := app.Scope().Invoke(invoker.Interface()); e != nil {
+		return value, newErrResolve(valueType, name, e)
+	}
+
+	return value, nil
+}
+
+func Provide[T any](
+	app Application,
+	constructor any,
+	opts ...dig.ProvideOption,
+) error {
+	if app == nil {
+		return newErrNilReference("app")
+	}
+
+	valueType := reflect.TypeFor[T]()
+	constructorType := reflect.TypeOf(constructor)
+	if constructorType == nil ||
+		constructorType.Kind() != reflect.Func ||
+		constructorType.NumOut() == 0 ||
+		constructorType.Out(0) != valueType {
+		return newErrInvalidConstructor(valueType, constructorType)
+	}
+
+	if e := app.Container().Provide(constructor, opts...); e != nil {
+		return newErrProvide(valueType, e)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package flam
+
+type RestartMode int
+
+const (
+	RestartNever RestartMode = iota
+	RestartOnFailure
+	RestartAlways
+)
+
+func (mode RestartMode) String() string {
+	switch mode {
+	case RestartNever:
+		return "never"
+	case RestartOnFailure:
+		return "on-failure"
+	case RestartAlways:
+		return "always"
+	default:
+		return "unknown"
+	}
+}
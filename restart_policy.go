@@ -0,0 +1,18 @@
+package flam
+
+import (
+	"time"
+)
+
+const (
+	MinRestartBackoff        = 10 * time.Millisecond
+	DefaultMaxRestartBackoff = time.Minute
+)
+
+type RestartPolicy struct {
+	Mode        RestartMode
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+	MaxRestarts int
+	Window      time.Duration
+}
@@ -0,0 +1,45 @@
+package flam
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+type ProviderTiming struct {
+	Id       string
+	State    ProviderState
+	Register time.Duration
+	Boot     time.Duration
+	RunStart time.Duration
+	Close    time.Duration
+}
+
+type StartupReport struct {
+	Started   time.Time
+	Boot      time.Duration
+	Providers []ProviderTiming
+}
+
+func (report StartupReport) Table() string {
+	builder := &strings.Builder{}
+	writer := tabwriter.NewWriter(builder, 0, 0, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(writer, "PROVIDER\tSTATE\tREGISTER\tBOOT\tRUN START\tCLOSE")
+	for _, timing := range report.Providers {
+		_, _ = fmt.Fprintf(
+			writer,
+			"%s\t%s\t%s\t%s\t%s\t%s\n",
+			timing.Id,
+			timing.State,
+			timing.Register,
+			timing.Boot,
+			timing.RunStart,
+			timing.Close)
+	}
+	_, _ = fmt.Fprintf(writer, "TOTAL\t\t\t%s\t\t\n", report.Boot)
+	_ = writer.Flush()
+
+	return builder.String()
+}
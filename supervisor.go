@@ -0,0 +1,84 @@
+package flam
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+type supervisor struct {
+	id     string
+	policy RestartPolicy
+	events PubSub[string, string]
+	run    func(ctx context.Context) error
+}
+
+func (supervisor *supervisor) Run(
+	ctx context.Context,
+) error {
+	var restarts []time.Time
+	initial := max(supervisor.policy.Backoff, MinRestartBackoff)
+	backoff := initial
+
+	limit := supervisor.policy.MaxBackoff
+	if limit <= 0 {
+		limit = max(DefaultMaxRestartBackoff, initial)
+	}
+
+	for {
+		start := time.Now()
+		e := supervisor.run(ctx)
+		if ctx.Err() != nil {
+			return e
+		}
+
+		if supervisor.policy.Window > 0 && time.Since(start) >= supervisor.policy.Window {
+			backoff = initial
+		}
+
+		if e != nil {
+			_ = supervisor.events.Publish(EventWorkerCrashed, supervisor.id, e)
+		}
+
+		switch supervisor.policy.Mode {
+		case RestartAlways:
+		case RestartOnFailure:
+			if e == nil {
+				return nil
+			}
+		default:
+			return e
+		}
+
+		now := time.Now()
+		if supervisor.policy.Window > 0 {
+			limit := now.Add(-supervisor.policy.Window)
+			restarts = slices.DeleteFunc(restarts, func(restart time.Time) bool {
+				return restart.Before(limit)
+			})
+		}
+
+		if supervisor.policy.MaxRestarts > 0 && len(restarts) >= supervisor.policy.MaxRestarts {
+			return errors.Join(e, newErrRestartLimitExceeded(supervisor.id, len(restarts)))
+		}
+		restarts = append(restarts, now)
+
+		_ = supervisor.events.Publish(EventWorkerRestart, supervisor.id, len(restarts), backoff)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return e
+		case <-timer.C:
+		}
+
+		if backoff < limit/2 {
+			backoff *= 2
+		} else {
+			backoff = limit
+		}
+		backoff = max(backoff, MinRestartBackoff)
+	}
+}
@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+type testCommandProvider struct {
+	testProvider
+	commands []flam.Command
+}
+
+func (p *testCommandProvider) Commands() []flam.Command {
+	return p.commands
+}
+
+func Test_Application_Execute(t *testing.T) {
+	t.Run("should return the usage exit code for an unknown command", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		app := flam.NewApplication(flam.WithOutput(output))
+		require.NoError(t, app.Register(&testCommandProvider{
+			testProvider: testProvider{id: "provider"},
+			commands: []flam.Command{{
+				Name:        "serve",
+				Description: "serve requests",
+				Handler:     func(*dig.Container, *flag.FlagSet) error { return nil },
+			}},
+		}))
+
+		assert.Equal(t, flam.ExitUsage, app.Execute([]string{"unknown"}))
+		assert.Contains(t, output.String(), "serve requests")
+		assert.Contains(t, output.String(), flam.ErrUnknownCommand.Error())
+	})
+
+	t.Run("should return the failure exit code for duplicate commands", func(t *testing.T) {
+		command := flam.Command{Name: "serve", Handler: func(*dig.Container, *flag.FlagSet) error { return nil }}
+
+		output := &bytes.Buffer{}
+		app := flam.NewApplication(flam.WithOutput(output))
+		require.NoError(t, app.Register(&testCommandProvider{testProvider: testProvider{id: "provider1"}, commands: []flam.Command{command}}))
+		require.NoError(t, app.Register(&testCommandProvider{testProvider: testProvider{id: "provider2"}, commands: []flam.Command{command}}))
+
+		assert.Equal(t, flam.ExitFailure, app.Execute([]string{"serve"}))
+		assert.Contains(t, output.String(), "serve provided by provider1 and provider2")
+	})
+
+	t.Run("should return the usage exit code for invalid flags", func(t *testing.T) {
+		app := flam.NewApplication(flam.WithOutput(&bytes.Buffer{}))
+		require.NoError(t, app.Register(&testCommandProvider{
+			testProvider: testProvider{id: "provider"},
+			commands: []flam.Command{{
+				Name:    "serve",
+				Handler: func(*dig.Container, *flag.FlagSet) error { return nil },
+			}},
+		}))
+
+		assert.Equal(t, flam.ExitUsage, app.Execute([]string{"serve", "--port", "80"}))
+	})
+
+	t.Run("should boot only the providers required by the command", func(t *testing.T) {
+		var booted []string
+		boot := func(id string) func(*dig.Container) error {
+			return func(*dig.Container) error {
+				booted = append(booted, id)
+				return nil
+			}
+		}
+
+		var table string
+		var dry bool
+		app := flam.NewApplication(flam.WithOutput(&bytes.Buffer{}))
+		require.NoError(t, app.Register(&testProvider{id: "config", boot: boot("config")}))
+		require.NoError(t, app.Register(&testProvider{id: "database", dependencies: []string{"config"}, boot: boot("database")}))
+		require.NoError(t, app.Register(&testProvider{id: "server", dependencies: []string{"config"}, boot: boot("server")}))
+		require.NoError(t, app.Register(&testCommandProvider{
+			testProvider: testProvider{id: "commands", boot: boot("commands")},
+			commands: []flam.Command{
+				{
+					Name:    "migrate",
+					Handler: func(*dig.Container, *flag.FlagSet) error { return nil },
+				},
+				{
+					Name:     "migrate table",
+					Requires: []string{"database"},
+					Flags: func(flags *flag.FlagSet) {
+						flags.BoolVar(&dry, "dry", false, "dry run")
+					},
+					Handler: func(_ *dig.Container, flags *flag.FlagSet) error {
+						table = flags.Arg(0)
+						return nil
+					},
+				},
+			},
+		}))
+
+		assert.Equal(t, flam.ExitSuccess, app.Execute([]string{"migrate", "table", "--dry", "users"}))
+		assert.Equal(t, []string{"config", "database", "commands"}, booted)
+		assert.Equal(t, "users", table)
+		assert.True(t, dry)
+		assert.Equal(t, flam.ApplicationClosed, app.State())
+	})
+
+	t.Run("should not close the providers unrelated to the command", func(t *testing.T) {
+		boots, closes := 0, 0
+		app := flam.NewApplication(flam.WithOutput(&bytes.Buffer{}))
+		require.NoError(t, app.Register(&testProvider{
+			id:    "other",
+			boot:  func(*dig.Container) error { boots++; return nil },
+			close: func(*dig.Container) error { closes++; return nil },
+		}))
+		require.NoError(t, app.Register(&testCommandProvider{
+			testProvider: testProvider{id: "commands"},
+			commands: []flam.Command{{
+				Name:    "noop",
+				Handler: func(*dig.Container, *flag.FlagSet) error { return nil },
+			}},
+		}))
+
+		assert.Equal(t, flam.ExitSuccess, app.Execute([]string{"noop"}))
+		assert.Equal(t, 0, boots)
+		assert.Equal(t, 0, closes)
+	})
+
+	t.Run("should map the handler error code to the exit code", func(t *testing.T) {
+		app := flam.NewApplication(flam.WithOutput(&bytes.Buffer{}))
+		require.NoError(t, app.Register(&testCommandProvider{
+			testProvider: testProvider{id: "provider"},
+			commands: []flam.Command{
+				{
+					Name:    "coded",
+					Handler: func(*dig.Container, *flag.FlagSet) error { return flam.NewError("failure").SetCode(42) },
+				},
+			},
+		}))
+
+		assert.Equal(t, 42, app.Execute([]string{"coded"}))
+	})
+
+	t.Run("should return the failure exit code on plain errors", func(t *testing.T) {
+		app := flam.NewApplication(flam.WithOutput(&bytes.Buffer{}))
+		require.NoError(t, app.Register(&testCommandProvider{
+			testProvider: testProvider{id: "provider"},
+			commands: []flam.Command{
+				{
+					Name:    "plain",
+					Handler: func(*dig.Container, *flag.FlagSet) error { return errors.New("failure") },
+				},
+			},
+		}))
+
+		assert.Equal(t, flam.ExitFailure, app.Execute([]string{"plain"}))
+	})
+}
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+func Test_Application_Providers(t *testing.T) {
+	t.Run("should return an empty list when no provider is registered", func(t *testing.T) {
+		assert.Empty(t, flam.NewApplication().Providers())
+	})
+
+	t.Run("should describe the registered providers", func(t *testing.T) {
+		expectedErr := errors.New("boot error")
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{id: "provider1"}))
+		require.NoError(t, app.Register(&testContextProvider{id: "provider2"}))
+
+		infos := app.Providers()
+		require.Len(t, infos, 2)
+		assert.Equal(t, "provider1", infos[0].Id)
+		assert.Equal(t, []string{"dependent", "bootable", "runnable", "closable"}, infos[0].Interfaces)
+		assert.Equal(t, flam.ProviderRegistered, infos[0].State)
+		assert.Equal(t, "provider2", infos[1].Id)
+		assert.Equal(t, []string{"runnable"}, infos[1].Interfaces)
+
+		require.NoError(t, app.Register(&testProvider{
+			id:   "provider3",
+			boot: func(*dig.Container) error { return expectedErr },
+		}))
+		assert.ErrorIs(t, app.Boot(), expectedErr)
+
+		infos = app.Providers()
+		require.Len(t, infos, 3)
+		assert.Equal(t, flam.ProviderClosed, infos[0].State)
+		assert.Equal(t, flam.ProviderClosed, infos[1].State)
+		assert.Equal(t, flam.ProviderFailed, infos[2].State)
+	})
+}
+
+func Test_Application_Provider(t *testing.T) {
+	provider := &testProvider{id: "provider"}
+
+	app := flam.NewApplication()
+	require.NoError(t, app.Register(provider))
+
+	t.Run("should return the registered provider", func(t *testing.T) {
+		registered, e := app.Provider("provider")
+		assert.NoError(t, e)
+		assert.Same(t, provider, registered)
+	})
+
+	t.Run("should return ErrUnknownProvider for an unregistered id", func(t *testing.T) {
+		registered, e := app.Provider("unknown")
+		assert.Nil(t, registered)
+		assert.ErrorIs(t, e, flam.ErrUnknownProvider)
+	})
+}
+
+func Test_Application_Unregister(t *testing.T) {
+	t.Run("should return ErrUnknownProvider for an unregistered id", func(t *testing.T) {
+		assert.ErrorIs(t, flam.NewApplication().Unregister("provider"), flam.ErrUnknownProvider)
+	})
+
+	t.Run("should return ErrProviderHasDependents when other providers depend on it", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{id: "config"}))
+		require.NoError(t, app.Register(&testProvider{id: "logger", dependencies: []string{"config"}}))
+
+		assert.ErrorIs(t, app.Unregister("config"), flam.ErrProviderHasDependents)
+	})
+
+	t.Run("should remove a provider that was not booted without closing it", func(t *testing.T) {
+		closed := false
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{
+			id:    "provider",
+			close: func(*dig.Container) error { closed = true; return nil },
+		}))
+
+		assert.NoError(t, app.Unregister("provider"))
+		assert.Empty(t, app.Providers())
+		assert.False(t, closed)
+	})
+
+	t.Run("should close a booted provider before removing it", func(t *testing.T) {
+		closed := 0
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{
+			id:    "provider",
+			close: func(*dig.Container) error { closed++; return nil },
+		}))
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Unregister("provider"))
+		assert.Empty(t, app.Providers())
+		assert.NoError(t, app.Close())
+		assert.Equal(t, 1, closed)
+	})
+}
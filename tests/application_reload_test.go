@@ -0,0 +1,168 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+type testVersion struct {
+	value string
+}
+
+type testReloadableProvider struct {
+	testProvider
+	version  string
+	booted   bool
+	closed   bool
+	closes   int
+	scopeErr error
+	bootErr  error
+}
+
+func (p *testReloadableProvider) Register(
+	container *dig.Container,
+) error {
+	return container.Provide(func() *testVersion {
+		return &testVersion{value: p.version}
+	})
+}
+
+func (p *testReloadableProvider) RegisterScope(
+	scope *dig.Scope,
+) error {
+	if p.scopeErr != nil {
+		return p.scopeErr
+	}
+
+	return scope.Provide(func() *testVersion {
+		return &testVersion{value: p.version}
+	})
+}
+
+func (p *testReloadableProvider) BootScope(
+	_ *dig.Scope,
+) error {
+	p.booted = true
+	return p.bootErr
+}
+
+func (p *testReloadableProvider) Close(
+	_ *dig.Container,
+) error {
+	p.closed = true
+	p.closes++
+	return nil
+}
+
+func Test_Application_Reload(t *testing.T) {
+	version := func(t *testing.T, scope *dig.Scope) string {
+		var value string
+		require.NoError(t, scope.Invoke(func(version *testVersion) {
+			value = version.value
+		}))
+		return value
+	}
+
+	t.Run("should return ErrInvalidApplicationState when not booted", func(t *testing.T) {
+		app := flam.NewApplication()
+
+		assert.ErrorIs(t, app.Reload("provider"), flam.ErrInvalidApplicationState)
+	})
+
+	t.Run("should return ErrUnknownProvider for an unregistered id", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Boot())
+
+		assert.ErrorIs(t, app.Reload("provider"), flam.ErrUnknownProvider)
+	})
+
+	t.Run("should return ErrProviderIdMismatch when the replacement id differs", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testReloadableProvider{testProvider: testProvider{id: "provider"}}))
+		require.NoError(t, app.Boot())
+
+		replacement := &testReloadableProvider{testProvider: testProvider{id: "other"}}
+		assert.ErrorIs(t, app.Reload("provider", replacement), flam.ErrProviderIdMismatch)
+	})
+
+	t.Run("should return ErrUnexpectedModule when the replacement is a module", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testReloadableProvider{testProvider: testProvider{id: "provider"}}))
+		require.NoError(t, app.Boot())
+
+		replacement := flam.NewModule("provider", &testReloadableProvider{testProvider: testProvider{id: "provider"}})
+		assert.ErrorIs(t, app.Reload("provider", replacement), flam.ErrUnexpectedModule)
+	})
+
+	t.Run("should return ErrProviderNotReloadable for non scoped providers", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{id: "provider"}))
+		require.NoError(t, app.Boot())
+
+		assert.ErrorIs(t, app.Reload("provider"), flam.ErrProviderNotReloadable)
+	})
+
+	t.Run("should keep the current provider when the replacement fails to register", func(t *testing.T) {
+		expectedErr := errors.New("register error")
+		current := &testReloadableProvider{testProvider: testProvider{id: "provider"}, version: "v1"}
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(current))
+		require.NoError(t, app.Boot())
+
+		replacement := &testReloadableProvider{testProvider: testProvider{id: "provider"}, scopeErr: expectedErr}
+		assert.ErrorIs(t, app.Reload("provider", replacement), expectedErr)
+		assert.False(t, current.closed)
+		assert.Equal(t, flam.ProviderBooted, app.Providers()[0].State)
+		assert.Equal(t, "v1", version(t, app.Scope()))
+
+		require.NoError(t, app.Close())
+		assert.Equal(t, 1, current.closes)
+	})
+
+	t.Run("should not close the replaced provider again when the replacement fails to boot", func(t *testing.T) {
+		expectedErr := errors.New("boot error")
+		current := &testReloadableProvider{testProvider: testProvider{id: "provider"}, version: "v1"}
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(current))
+		require.NoError(t, app.Boot())
+
+		replacement := &testReloadableProvider{testProvider: testProvider{id: "provider"}, version: "v2", bootErr: expectedErr}
+		assert.ErrorIs(t, app.Reload("provider", replacement), expectedErr)
+		assert.Equal(t, flam.ProviderFailed, app.Providers()[0].State)
+
+		require.NoError(t, app.Close())
+		assert.Equal(t, 1, current.closes)
+		assert.Equal(t, 0, replacement.closes)
+	})
+
+	t.Run("should replace the provider in a fresh scope", func(t *testing.T) {
+		current := &testReloadableProvider{testProvider: testProvider{id: "provider"}, version: "v1"}
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(current))
+		require.NoError(t, app.Boot())
+		assert.Equal(t, "v1", version(t, app.Scope()))
+
+		replacement := &testReloadableProvider{testProvider: testProvider{id: "provider"}, version: "v2"}
+		require.NoError(t, app.Reload("provider", replacement))
+
+		assert.True(t, current.closed)
+		assert.True(t, replacement.booted)
+		assert.Equal(t, "v2", version(t, app.Scope()))
+
+		replacement = &testReloadableProvider{testProvider: testProvider{id: "provider"}, version: "v3"}
+		require.NoError(t, app.Reload("provider", replacement))
+		assert.Equal(t, "v3", version(t, app.Scope()))
+
+		require.NoError(t, app.Close())
+		assert.True(t, replacement.closed)
+	})
+}
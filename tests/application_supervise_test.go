@@ -0,0 +1,188 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+type testSupervisedProvider struct {
+	testProvider
+	policy flam.RestartPolicy
+}
+
+func (p *testSupervisedProvider) RestartPolicy() flam.RestartPolicy {
+	return p.policy
+}
+
+func Test_Application_Supervise(t *testing.T) {
+	t.Run("should return ErrNilReference when worker is nil", func(t *testing.T) {
+		app := flam.NewApplication()
+
+		assert.ErrorIs(t, app.Supervise("worker", flam.RestartPolicy{}, nil), flam.ErrNilReference)
+	})
+
+	t.Run("should return ErrDuplicateWorker when the id is already supervised", func(t *testing.T) {
+		worker := func(context.Context) error { return nil }
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Supervise("worker", flam.RestartPolicy{}, worker))
+
+		assert.ErrorIs(t, app.Supervise("worker", flam.RestartPolicy{}, worker), flam.ErrDuplicateWorker)
+	})
+
+	t.Run("should not restart a worker with the never policy", func(t *testing.T) {
+		expectedErr := errors.New("worker error")
+
+		calls := 0
+		app := flam.NewApplication()
+		require.NoError(t, app.Supervise("worker", flam.RestartPolicy{Mode: flam.RestartNever}, func(context.Context) error {
+			calls++
+			return expectedErr
+		}))
+
+		assert.ErrorIs(t, app.Run(), expectedErr)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should restart a failing worker and publish the crash events", func(t *testing.T) {
+		calls := 0
+		crashes := 0
+		var restarts []any
+
+		app := flam.NewApplication()
+		app.Events().Subscribe("test", flam.EventWorkerCrashed, func(_, _ string, data ...any) error {
+			assert.Equal(t, "worker", data[0])
+			crashes++
+			return nil
+		})
+		app.Events().Subscribe("test", flam.EventWorkerRestart, func(_, _ string, data ...any) error {
+			restarts = append(restarts, data[2])
+			return nil
+		})
+
+		policy := flam.RestartPolicy{Mode: flam.RestartOnFailure, Backoff: 10 * time.Millisecond, MaxBackoff: 30 * time.Millisecond}
+		require.NoError(t, app.Supervise("worker", policy, func(context.Context) error {
+			calls++
+			if calls < 4 {
+				return errors.New("worker error")
+			}
+			return nil
+		}))
+
+		assert.NoError(t, app.Run())
+		assert.Equal(t, 4, calls)
+		assert.Equal(t, 3, crashes)
+		assert.Equal(t, []any{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond}, restarts)
+	})
+
+	t.Run("should enforce the minimum restart backoff", func(t *testing.T) {
+		var restarts []any
+
+		app := flam.NewApplication()
+		app.Events().Subscribe("test", flam.EventWorkerRestart, func(_, _ string, data ...any) error {
+			restarts = append(restarts, data[2])
+			return nil
+		})
+
+		calls := 0
+		policy := flam.RestartPolicy{Mode: flam.RestartAlways, MaxBackoff: time.Millisecond, MaxRestarts: 2}
+		require.NoError(t, app.Supervise("worker", policy, func(context.Context) error {
+			calls++
+			return nil
+		}))
+
+		assert.ErrorIs(t, app.Run(), flam.ErrRestartLimitExceeded)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, []any{flam.MinRestartBackoff, flam.MinRestartBackoff}, restarts)
+	})
+
+	t.Run("should reset the backoff after a run outliving the window", func(t *testing.T) {
+		var restarts []any
+
+		app := flam.NewApplication()
+		app.Events().Subscribe("test", flam.EventWorkerRestart, func(_, _ string, data ...any) error {
+			restarts = append(restarts, data[2])
+			return nil
+		})
+
+		calls := 0
+		policy := flam.RestartPolicy{Mode: flam.RestartOnFailure, Backoff: 10 * time.Millisecond, Window: 50 * time.Millisecond}
+		require.NoError(t, app.Supervise("worker", policy, func(context.Context) error {
+			calls++
+			switch calls {
+			case 3:
+				time.Sleep(60 * time.Millisecond)
+			case 5:
+				return nil
+			}
+			return errors.New("worker error")
+		}))
+
+		assert.NoError(t, app.Run())
+		assert.Equal(t, []any{10 * time.Millisecond, 20 * time.Millisecond, 10 * time.Millisecond, 20 * time.Millisecond}, restarts)
+	})
+
+	t.Run("should return ErrRestartLimitExceeded when exceeding the restarts in the window", func(t *testing.T) {
+		expectedErr := errors.New("worker error")
+
+		calls := 0
+		app := flam.NewApplication()
+		policy := flam.RestartPolicy{Mode: flam.RestartOnFailure, MaxRestarts: 2, Window: time.Minute}
+		require.NoError(t, app.Supervise("worker", policy, func(context.Context) error {
+			calls++
+			return expectedErr
+		}))
+
+		e := app.Run()
+		assert.ErrorIs(t, e, expectedErr)
+		assert.ErrorIs(t, e, flam.ErrRestartLimitExceeded)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("should supervise runnable providers declaring a restart policy", func(t *testing.T) {
+		calls := 0
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testSupervisedProvider{
+			testProvider: testProvider{
+				id: "provider",
+				run: func(*dig.Container) error {
+					calls++
+					if calls == 1 {
+						return errors.New("run error")
+					}
+					return nil
+				},
+			},
+			policy: flam.RestartPolicy{Mode: flam.RestartOnFailure},
+		}))
+
+		assert.NoError(t, app.Run())
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should stop restarting when the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		calls := atomic.Int32{}
+		app := flam.NewApplication()
+		require.NoError(t, app.Supervise("worker", flam.RestartPolicy{Mode: flam.RestartAlways}, func(ctx context.Context) error {
+			if calls.Add(1) == 3 {
+				cancel()
+				<-ctx.Done()
+			}
+			return nil
+		}))
+
+		assert.NoError(t, app.RunContext(ctx))
+		assert.Equal(t, int32(3), calls.Load())
+	})
+}
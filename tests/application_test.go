@@ -0,0 +1,757 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+type testProvider struct {
+	id           string
+	dependencies []string
+	boot         func(container *dig.Container) error
+	run          func(container *dig.Container) error
+	close        func(container *dig.Container) error
+}
+
+func (p *testProvider) Id() string {
+	return p.id
+}
+
+func (p *testProvider) DependsOn() []string {
+	return p.dependencies
+}
+
+func (p *testProvider) Register(
+	_ *dig.Container,
+) error {
+	return nil
+}
+
+func (p *testProvider) Boot(
+	container *dig.Container,
+) error {
+	if p.boot == nil {
+		return nil
+	}
+	return p.boot(container)
+}
+
+func (p *testProvider) Run(
+	container *dig.Container,
+) error {
+	if p.run == nil {
+		return nil
+	}
+	return p.run(container)
+}
+
+func (p *testProvider) Close(
+	container *dig.Container,
+) error {
+	if p.close == nil {
+		return nil
+	}
+	return p.close(container)
+}
+
+type testContextProvider struct {
+	id  string
+	run func(ctx context.Context, container *dig.Container) error
+}
+
+func (p *testContextProvider) Id() string {
+	return p.id
+}
+
+func (p *testContextProvider) Register(
+	_ *dig.Container,
+) error {
+	return nil
+}
+
+func (p *testContextProvider) RunContext(
+	ctx context.Context,
+	container *dig.Container,
+) error {
+	return p.run(ctx, container)
+}
+
+type testBootContextProvider struct {
+	id   string
+	boot func(ctx context.Context, container *dig.Container) error
+}
+
+func (p *testBootContextProvider) Id() string {
+	return p.id
+}
+
+func (p *testBootContextProvider) Register(
+	_ *dig.Container,
+) error {
+	return nil
+}
+
+func (p *testBootContextProvider) BootContext(
+	ctx context.Context,
+	container *dig.Container,
+) error {
+	return p.boot(ctx, container)
+}
+
+type testCloseProvider struct {
+	id    string
+	close func(container *dig.Container) error
+}
+
+func (p *testCloseProvider) Id() string {
+	return p.id
+}
+
+func (p *testCloseProvider) Register(
+	_ *dig.Container,
+) error {
+	return nil
+}
+
+func (p *testCloseProvider) Close(
+	container *dig.Container,
+) error {
+	return p.close(container)
+}
+
+type testBootA struct{}
+
+type testBootB struct{}
+
+func Test_Application_State(t *testing.T) {
+	t.Run("should follow the application lifecycle", func(t *testing.T) {
+		app := flam.NewApplication()
+		assert.Equal(t, flam.ApplicationCreated, app.State())
+
+		require.NoError(t, app.Register(&testProvider{id: "provider"}))
+		assert.Equal(t, flam.ApplicationRegistered, app.State())
+
+		require.NoError(t, app.Boot())
+		assert.Equal(t, flam.ApplicationBooted, app.State())
+
+		require.NoError(t, app.Run())
+		assert.Equal(t, flam.ApplicationRunning, app.State())
+
+		require.NoError(t, app.Close())
+		assert.Equal(t, flam.ApplicationClosed, app.State())
+	})
+
+	t.Run("should report closing while closing providers", func(t *testing.T) {
+		app := flam.NewApplication()
+
+		var state flam.ApplicationState
+		require.NoError(t, app.Register(&testProvider{
+			id:    "provider",
+			close: func(*dig.Container) error { state = app.State(); return nil },
+		}))
+
+		require.NoError(t, app.Boot())
+		require.NoError(t, app.Close())
+		assert.Equal(t, flam.ApplicationClosing, state)
+	})
+
+	t.Run("should return ErrInvalidApplicationState on register after boot", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Boot())
+
+		assert.ErrorIs(t, app.Register(&testProvider{id: "provider"}), flam.ErrInvalidApplicationState)
+	})
+
+	t.Run("should return ErrInvalidApplicationState on run after close", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Close())
+
+		assert.ErrorIs(t, app.Run(), flam.ErrInvalidApplicationState)
+		assert.ErrorIs(t, app.Boot(), flam.ErrInvalidApplicationState)
+	})
+
+	t.Run("should return ErrInvalidApplicationState on run while running", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Run())
+
+		assert.ErrorIs(t, app.Run(), flam.ErrInvalidApplicationState)
+	})
+
+	t.Run("should close only once", func(t *testing.T) {
+		calls := 0
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{
+			id:    "provider",
+			close: func(*dig.Container) error { calls++; return nil },
+		}))
+
+		require.NoError(t, app.Boot())
+		assert.NoError(t, app.Close())
+		assert.NoError(t, app.Close())
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func Test_Application_Events(t *testing.T) {
+	t.Run("should publish the lifecycle events", func(t *testing.T) {
+		expectedErr := errors.New("boot error")
+
+		var events []string
+		handler := func(_, channel string, data ...any) error {
+			events = append(events, channel)
+			if channel == flam.EventProviderBooted {
+				require.Len(t, data, 3)
+				assert.Equal(t, "provider", data[0])
+				assert.IsType(t, time.Duration(0), data[1])
+				assert.ErrorIs(t, data[2].(error), expectedErr)
+			}
+			return nil
+		}
+
+		app := flam.NewApplication()
+		for _, channel := range []string{
+			flam.EventBeforeBoot,
+			flam.EventProviderBooted,
+			flam.EventBeforeRun,
+			flam.EventBeforeClose,
+			flam.EventClosed,
+		} {
+			app.Events().Subscribe("test", channel, handler)
+		}
+
+		require.NoError(t, app.Register(&testProvider{
+			id:   "provider",
+			boot: func(*dig.Container) error { return expectedErr },
+		}))
+
+		assert.ErrorIs(t, app.Boot(), expectedErr)
+		assert.NoError(t, app.Close())
+		assert.Equal(t, []string{
+			flam.EventBeforeBoot,
+			flam.EventProviderBooted,
+			flam.EventBeforeClose,
+			flam.EventClosed,
+		}, events)
+	})
+
+	t.Run("should publish the run event", func(t *testing.T) {
+		called := false
+		app := flam.NewApplication()
+		app.Events().Subscribe("test", flam.EventBeforeRun, func(_, _ string, _ ...any) error {
+			called = true
+			return nil
+		})
+
+		assert.NoError(t, app.Run())
+		assert.True(t, called)
+	})
+
+	t.Run("should abort the boot when a before boot handler fails", func(t *testing.T) {
+		expectedErr := errors.New("hook error")
+
+		booted := false
+		app := flam.NewApplication()
+		app.Events().Subscribe("test", flam.EventBeforeBoot, func(_, _ string, _ ...any) error {
+			return expectedErr
+		})
+		require.NoError(t, app.Register(&testProvider{
+			id:   "provider",
+			boot: func(*dig.Container) error { booted = true; return nil },
+		}))
+
+		assert.ErrorIs(t, app.Boot(), expectedErr)
+		assert.False(t, booted)
+		assert.Equal(t, flam.ApplicationRegistered, app.State())
+	})
+}
+
+func Test_Application_Boot(t *testing.T) {
+	t.Run("should boot providers in dependency order", func(t *testing.T) {
+		var order []string
+		boot := func(id string) func(*dig.Container) error {
+			return func(*dig.Container) error {
+				order = append(order, id)
+				return nil
+			}
+		}
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{id: "logger", dependencies: []string{"config"}, boot: boot("logger")}))
+		require.NoError(t, app.Register(&testProvider{id: "server", dependencies: []string{"logger", "config"}, boot: boot("server")}))
+		require.NoError(t, app.Register(&testProvider{id: "config", boot: boot("config")}))
+
+		assert.NoError(t, app.Boot())
+		assert.Equal(t, []string{"config", "logger", "server"}, order)
+	})
+
+	t.Run("should return ErrUnknownProviderDependency on a missing dependency", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{id: "logger", dependencies: []string{"config"}}))
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrUnknownProviderDependency)
+	})
+
+	t.Run("should return ErrProviderDependencyCycle on a dependency cycle", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{id: "a", dependencies: []string{"b"}}))
+		require.NoError(t, app.Register(&testProvider{id: "b", dependencies: []string{"c"}}))
+		require.NoError(t, app.Register(&testProvider{id: "c", dependencies: []string{"b"}}))
+
+		e := app.Boot()
+		assert.ErrorIs(t, e, flam.ErrProviderDependencyCycle)
+		assert.ErrorContains(t, e, "b -> c -> b")
+	})
+}
+
+func Test_Application_Boot_Parallel(t *testing.T) {
+	t.Run("should boot independent providers concurrently", func(t *testing.T) {
+		started := sync.WaitGroup{}
+		started.Add(3)
+		boot := func(*dig.Container) error {
+			started.Done()
+			started.Wait()
+			return nil
+		}
+
+		app := flam.NewApplication(flam.WithBootWorkers(3))
+		require.NoError(t, app.Register(&testProvider{id: "provider1", boot: boot}))
+		require.NoError(t, app.Register(&testProvider{id: "provider2", boot: boot}))
+		require.NoError(t, app.Register(&testProvider{id: "provider3", boot: boot}))
+
+		done := make(chan error)
+		go func() { done <- app.Boot() }()
+
+		select {
+		case e := <-done:
+			assert.NoError(t, e)
+		case <-time.After(time.Second):
+			t.Fatal("providers were not booted concurrently")
+		}
+	})
+
+	t.Run("should aggregate the errors of all failing providers", func(t *testing.T) {
+		expectedErr1 := errors.New("boot error 1")
+		expectedErr2 := errors.New("boot error 2")
+
+		locker := sync.Mutex{}
+		var booted []string
+		boot := func(id string, e error) func(*dig.Container) error {
+			return func(*dig.Container) error {
+				locker.Lock()
+				defer locker.Unlock()
+
+				booted = append(booted, id)
+				return e
+			}
+		}
+
+		app := flam.NewApplication(flam.WithBootWorkers(2))
+		require.NoError(t, app.Register(&testProvider{id: "provider1", boot: boot("provider1", expectedErr1)}))
+		require.NoError(t, app.Register(&testProvider{id: "provider2", boot: boot("provider2", expectedErr2)}))
+		require.NoError(t, app.Register(&testProvider{id: "provider3", dependencies: []string{"provider1"}, boot: boot("provider3", nil)}))
+		require.NoError(t, app.Register(&testProvider{id: "provider4", boot: boot("provider4", nil)}))
+
+		e := app.Boot()
+		assert.ErrorIs(t, e, expectedErr1)
+		assert.ErrorIs(t, e, expectedErr2)
+		assert.ElementsMatch(t, []string{"provider1", "provider2", "provider4"}, booted)
+	})
+
+	t.Run("should serialize the container access of concurrent boots", func(t *testing.T) {
+		app := flam.NewApplication(flam.WithBootWorkers(8))
+		require.NoError(t, app.Container().Provide(func() *testBootA { return &testBootA{} }))
+		require.NoError(t, app.Container().Provide(func() *testBootB { return &testBootB{} }))
+
+		invoked := atomic.Int32{}
+		invoke := func(*testBootA, *testBootB) { invoked.Add(1) }
+		for i := range 8 {
+			require.NoError(t, app.Register(&testProvider{
+				id: fmt.Sprintf("provider%d", i),
+				boot: func(container *dig.Container) error {
+					return flam.Invoke(container, invoke)
+				},
+			}))
+			require.NoError(t, app.Register(&testBootContextProvider{
+				id: fmt.Sprintf("context%d", i),
+				boot: func(_ context.Context, container *dig.Container) error {
+					return flam.Invoke(container, invoke)
+				},
+			}))
+		}
+
+		require.NoError(t, app.Boot())
+		assert.Equal(t, int32(16), invoked.Load())
+	})
+}
+
+func Test_Application_Boot_Rollback(t *testing.T) {
+	t.Run("should close the booted providers in reverse order on failure", func(t *testing.T) {
+		expectedErr := errors.New("boot error")
+		rollbackErr := errors.New("rollback error")
+
+		var closed []string
+		closer := func(id string, e error) func(*dig.Container) error {
+			return func(*dig.Container) error {
+				closed = append(closed, id)
+				return e
+			}
+		}
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{id: "provider1", close: closer("provider1", nil)}))
+		require.NoError(t, app.Register(&testProvider{id: "provider2", close: closer("provider2", rollbackErr)}))
+		require.NoError(t, app.Register(&testProvider{
+			id:    "provider3",
+			boot:  func(*dig.Container) error { return expectedErr },
+			close: closer("provider3", nil),
+		}))
+		require.NoError(t, app.Register(&testProvider{id: "provider4", dependencies: []string{"provider3"}, close: closer("provider4", nil)}))
+
+		e := app.Boot()
+		assert.ErrorIs(t, e, expectedErr)
+		assert.ErrorIs(t, e, rollbackErr)
+		assert.Equal(t, []string{"provider2", "provider1"}, closed)
+	})
+
+	t.Run("should close the providers without a boot step on failure", func(t *testing.T) {
+		expectedErr := errors.New("boot error")
+
+		closes := 0
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testCloseProvider{
+			id:    "pool",
+			close: func(*dig.Container) error { closes++; return nil },
+		}))
+		require.NoError(t, app.Register(&testProvider{
+			id:           "consumer",
+			dependencies: []string{"pool"},
+			boot:         func(*dig.Container) error { return expectedErr },
+		}))
+
+		assert.ErrorIs(t, app.Boot(), expectedErr)
+		assert.Equal(t, 1, closes)
+		assert.Equal(t, flam.ProviderClosed, app.Providers()[0].State)
+
+		require.NoError(t, app.Close())
+		assert.Equal(t, 1, closes)
+	})
+}
+
+func Test_Application_Close(t *testing.T) {
+	t.Run("should not close rolled back or failed providers again", func(t *testing.T) {
+		expectedErr := errors.New("boot error")
+
+		closes := map[string]int{}
+		closer := func(id string) func(*dig.Container) error {
+			return func(*dig.Container) error {
+				closes[id]++
+				return nil
+			}
+		}
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{id: "a", close: closer("a")}))
+		require.NoError(t, app.Register(&testProvider{
+			id:           "b",
+			dependencies: []string{"a"},
+			boot:         func(*dig.Container) error { return expectedErr },
+			close:        closer("b"),
+		}))
+
+		assert.ErrorIs(t, app.Boot(), expectedErr)
+		assert.NoError(t, app.Close())
+		assert.Equal(t, map[string]int{"a": 1}, closes)
+	})
+
+	t.Run("should not close providers that were never booted", func(t *testing.T) {
+		closed := false
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{
+			id:    "provider",
+			close: func(*dig.Container) error { closed = true; return nil },
+		}))
+
+		assert.NoError(t, app.Close())
+		assert.False(t, closed)
+		assert.Equal(t, flam.ProviderRegistered, app.Providers()[0].State)
+	})
+
+	t.Run("should close providers in reverse dependency order", func(t *testing.T) {
+		var order []string
+		closer := func(id string) func(*dig.Container) error {
+			return func(*dig.Container) error {
+				order = append(order, id)
+				return nil
+			}
+		}
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{id: "logger", dependencies: []string{"config"}, close: closer("logger")}))
+		require.NoError(t, app.Register(&testProvider{id: "config", close: closer("config")}))
+		require.NoError(t, app.Register(&testProvider{id: "server", dependencies: []string{"logger"}, close: closer("server")}))
+
+		require.NoError(t, app.Boot())
+		assert.NoError(t, app.Close())
+		assert.Equal(t, []string{"server", "logger", "config"}, order)
+	})
+
+	t.Run("should close all providers and aggregate the errors", func(t *testing.T) {
+		expectedErr1 := errors.New("close error 1")
+		expectedErr2 := errors.New("close error 2")
+
+		closed := 0
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{
+			id:    "provider1",
+			close: func(*dig.Container) error { closed++; return expectedErr1 },
+		}))
+		require.NoError(t, app.Register(&testProvider{
+			id:    "provider2",
+			close: func(*dig.Container) error { closed++; return nil },
+		}))
+		require.NoError(t, app.Register(&testProvider{
+			id:    "provider3",
+			close: func(*dig.Container) error { closed++; return expectedErr2 },
+		}))
+
+		require.NoError(t, app.Boot())
+		e := app.Close()
+		assert.Equal(t, 3, closed)
+		assert.ErrorIs(t, e, expectedErr1)
+		assert.ErrorIs(t, e, expectedErr2)
+
+		joined, ok := e.(interface{ Unwrap() []error })
+		require.True(t, ok)
+
+		var ids []any
+		for _, member := range joined.Unwrap() {
+			var flamErr flam.Error
+			require.ErrorAs(t, member, &flamErr)
+			ids = append(ids, flamErr.Get("provider"))
+		}
+		assert.Equal(t, []any{"provider3", "provider1"}, ids)
+	})
+}
+
+func Test_Application_Timeouts(t *testing.T) {
+	t.Run("should return ErrProviderTimeout naming the provider on boot timeout", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		app := flam.NewApplication(flam.WithTimeouts(flam.Bag{"boot": 10 * time.Millisecond}))
+		require.NoError(t, app.Register(&testProvider{
+			id:   "provider",
+			boot: func(*dig.Container) error { <-release; return nil },
+		}))
+
+		e := app.Boot()
+		assert.ErrorIs(t, e, flam.ErrProviderTimeout)
+		assert.ErrorContains(t, e, "provider(boot)")
+	})
+
+	t.Run("should apply the provider specific timeout on close", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		app := flam.NewApplication(flam.WithTimeouts(flam.Bag{
+			"close": 0,
+			"providers": flam.Bag{
+				"slow.provider": flam.Bag{"close": 10},
+			},
+		}))
+		require.NoError(t, app.Register(&testProvider{id: "fast.provider"}))
+		require.NoError(t, app.Register(&testProvider{
+			id:    "slow.provider",
+			close: func(*dig.Container) error { <-release; return nil },
+		}))
+
+		require.NoError(t, app.Boot())
+		e := app.Close()
+		assert.ErrorIs(t, e, flam.ErrProviderTimeout)
+		assert.ErrorContains(t, e, "slow.provider(close)")
+	})
+
+	t.Run("should pass a context with the deadline to context providers", func(t *testing.T) {
+		app := flam.NewApplication(flam.WithTimeouts(flam.Bag{"run": time.Second}))
+		require.NoError(t, app.Register(&testContextProvider{
+			id: "provider",
+			run: func(ctx context.Context, _ *dig.Container) error {
+				_, ok := ctx.Deadline()
+				assert.True(t, ok)
+				return nil
+			},
+		}))
+
+		assert.NoError(t, app.Run())
+	})
+
+	t.Run("should read the timeouts of a decoded configuration", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		config, e := flam.ParseJSON([]byte(`{"providers": {"slow.provider": {"boot": "10ms"}, "fast.provider": {"boot": 1000}}}`))
+		require.NoError(t, e)
+
+		app := flam.NewApplication(flam.WithTimeouts(config))
+		require.NoError(t, app.Register(&testProvider{id: "fast.provider"}))
+		require.NoError(t, app.Register(&testProvider{
+			id:   "slow.provider",
+			boot: func(*dig.Container) error { <-release; return nil },
+		}))
+
+		e = app.Boot()
+		assert.ErrorIs(t, e, flam.ErrProviderTimeout)
+		assert.ErrorContains(t, e, "slow.provider(boot)")
+	})
+
+	t.Run("should not report a cancelled context as a timeout", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		expectedErr := errors.New("run error")
+
+		app := flam.NewApplication(flam.WithTimeouts(flam.Bag{"run": time.Minute}))
+		require.NoError(t, app.Register(&testContextProvider{
+			id: "provider",
+			run: func(ctx context.Context, _ *dig.Container) error {
+				cancel()
+				<-ctx.Done()
+				return expectedErr
+			},
+		}))
+
+		e := app.RunContext(ctx)
+		assert.ErrorIs(t, e, expectedErr)
+		assert.NotErrorIs(t, e, flam.ErrProviderTimeout)
+	})
+}
+
+func Test_Application_RunContext(t *testing.T) {
+	t.Run("should return the boot error", func(t *testing.T) {
+		expectedErr := errors.New("boot error")
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{
+			id:   "provider",
+			boot: func(*dig.Container) error { return expectedErr },
+		}))
+
+		assert.ErrorIs(t, app.RunContext(context.Background()), expectedErr)
+	})
+
+	t.Run("should run all runnables concurrently until the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		started := sync.WaitGroup{}
+		started.Add(2)
+		run := func(ctx context.Context, _ *dig.Container) error {
+			started.Done()
+			<-ctx.Done()
+			return nil
+		}
+
+		closed := false
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testContextProvider{id: "provider1", run: run}))
+		require.NoError(t, app.Register(&testContextProvider{id: "provider2", run: run}))
+		require.NoError(t, app.Register(&testProvider{
+			id:    "provider3",
+			close: func(*dig.Container) error { closed = true; return nil },
+		}))
+
+		go func() {
+			started.Wait()
+			cancel()
+		}()
+
+		assert.NoError(t, app.RunContext(ctx))
+		assert.True(t, closed)
+	})
+
+	t.Run("should not report the cancellation returned by the runnables on shutdown", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testContextProvider{
+			id: "provider",
+			run: func(ctx context.Context, _ *dig.Container) error {
+				cancel()
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		}))
+
+		assert.NoError(t, app.RunContext(ctx))
+	})
+
+	t.Run("should cancel all runnables on the first failure", func(t *testing.T) {
+		expectedErr := errors.New("run error")
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testContextProvider{
+			id: "provider1",
+			run: func(ctx context.Context, _ *dig.Container) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		}))
+		require.NoError(t, app.Register(&testProvider{
+			id:  "provider2",
+			run: func(*dig.Container) error { return expectedErr },
+		}))
+
+		e := app.RunContext(context.Background())
+		assert.ErrorIs(t, e, expectedErr)
+		assert.NotErrorIs(t, e, context.Canceled)
+	})
+
+	t.Run("should report a cancellation returned before shutdown", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testContextProvider{
+			id: "provider",
+			run: func(context.Context, *dig.Container) error {
+				return context.Canceled
+			},
+		}))
+
+		assert.ErrorIs(t, app.RunContext(context.Background()), context.Canceled)
+	})
+
+	t.Run("should return ErrShutdownTimeout when the grace period expires", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		release := make(chan struct{})
+		defer close(release)
+
+		app := flam.NewApplication(flam.WithGracePeriod(10 * time.Millisecond))
+		require.NoError(t, app.Register(&testProvider{
+			id:  "provider",
+			run: func(*dig.Container) error { <-release; return nil },
+		}))
+
+		assert.ErrorIs(t, app.RunContext(ctx), flam.ErrShutdownTimeout)
+	})
+
+	t.Run("should close the application when all runnables finish", func(t *testing.T) {
+		closed := false
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{
+			id:    "provider",
+			close: func(*dig.Container) error { closed = true; return nil },
+		}))
+
+		assert.NoError(t, app.RunContext(context.Background()))
+		assert.True(t, closed)
+	})
+}
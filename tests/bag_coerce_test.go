@@ -0,0 +1,232 @@
+package tests
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+func Test_Bag_IntE(t *testing.T) {
+	scenarios := []struct {
+		test     string
+		bag      flam.Bag
+		path     string
+		expected int
+		err      error
+	}{
+		{
+			test: "should return invalid path error on missing path",
+			bag:  flam.Bag{},
+			path: "field",
+			err:  flam.ErrBagInvalidPath,
+		},
+		{
+			test:     "should return an int value",
+			bag:      flam.Bag{"field": 123},
+			path:     "field",
+			expected: 123,
+		},
+		{
+			test:     "should convert an integral float value",
+			bag:      flam.Bag{"field": float64(123)},
+			path:     "field",
+			expected: 123,
+		},
+		{
+			test:     "should convert an unsigned value",
+			bag:      flam.Bag{"field": uint8(123)},
+			path:     "field",
+			expected: 123,
+		},
+		{
+			test:     "should parse a numeric string",
+			bag:      flam.Bag{"field": " -123 "},
+			path:     "field",
+			expected: -123,
+		},
+		{
+			test:     "should parse a json number",
+			bag:      flam.Bag{"field": json.Number("123")},
+			path:     "field",
+			expected: 123,
+		},
+		{
+			test: "should return conversion error on non integral float",
+			bag:  flam.Bag{"field": 1.5},
+			path: "field",
+			err:  flam.ErrBagValueConversion,
+		},
+		{
+			test: "should return conversion error on non numeric string",
+			bag:  flam.Bag{"field": "abc"},
+			path: "field",
+			err:  flam.ErrBagValueConversion,
+		},
+		{
+			test: "should return conversion error on non numeric value",
+			bag:  flam.Bag{"field": []any{1}},
+			path: "field",
+			err:  flam.ErrBagValueConversion,
+		},
+		{
+			test: "should return overflow error on too large unsigned value",
+			bag:  flam.Bag{"field": uint64(math.MaxUint64)},
+			path: "field",
+			err:  flam.ErrBagValueOverflow,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.test, func(t *testing.T) {
+			result, e := scenario.bag.IntE(scenario.path)
+			assert.Equal(t, scenario.expected, result)
+			if scenario.err != nil {
+				assert.ErrorIs(t, e, scenario.err)
+			} else {
+				assert.NoError(t, e)
+			}
+		})
+	}
+}
+
+func Test_Bag_CoercionOverflow(t *testing.T) {
+	bag := flam.Bag{
+		"negative": -1,
+		"large":    300,
+		"string":   "70000",
+		"float":    float64(math.MaxFloat64),
+		"huge":     "1e400",
+		"bound":    float64(math.MaxInt64),
+	}
+
+	_, e := bag.Int8E("large")
+	assert.ErrorIs(t, e, flam.ErrBagValueOverflow)
+
+	_, e = bag.Int16E("string")
+	assert.ErrorIs(t, e, flam.ErrBagValueOverflow)
+
+	_, e = bag.Uint64E("negative")
+	assert.ErrorIs(t, e, flam.ErrBagValueOverflow)
+
+	_, e = bag.Uint8E("large")
+	assert.ErrorIs(t, e, flam.ErrBagValueOverflow)
+
+	_, e = bag.Float32E("float")
+	assert.ErrorIs(t, e, flam.ErrBagValueOverflow)
+
+	_, e = bag.Float64E("huge")
+	assert.ErrorIs(t, e, flam.ErrBagValueOverflow)
+
+	_, e = bag.Int64E("bound")
+	assert.ErrorIs(t, e, flam.ErrBagValueOverflow)
+
+	value, e := bag.Int32E("string")
+	assert.NoError(t, e)
+	assert.Equal(t, int32(70000), value)
+
+	unsigned, e := bag.Uint16E("large")
+	assert.NoError(t, e)
+	assert.Equal(t, uint16(300), unsigned)
+}
+
+func Test_Bag_CoercionVariants(t *testing.T) {
+	bag := flam.Bag{
+		"int":      1,
+		"float":    2.5,
+		"string":   "3.5",
+		"bool":     "true",
+		"duration": "1s",
+		"ms":       "250",
+		"uint":     uint(4),
+		"nested":   flam.Bag{"field": "5"},
+	}
+
+	b, e := bag.BoolE("bool")
+	assert.NoError(t, e)
+	assert.True(t, b)
+
+	b, e = bag.BoolE("int")
+	assert.NoError(t, e)
+	assert.True(t, b)
+
+	_, e = bag.BoolE("float")
+	assert.ErrorIs(t, e, flam.ErrBagValueConversion)
+
+	f, e := bag.Float64E("string")
+	assert.NoError(t, e)
+	assert.Equal(t, 3.5, f)
+
+	f32, e := bag.Float32E("int")
+	assert.NoError(t, e)
+	assert.Equal(t, float32(1), f32)
+
+	s, e := bag.StringE("float")
+	assert.NoError(t, e)
+	assert.Equal(t, "2.5", s)
+
+	s, e = bag.StringE("uint")
+	assert.NoError(t, e)
+	assert.Equal(t, "4", s)
+
+	_, e = bag.StringE("nested")
+	assert.ErrorIs(t, e, flam.ErrBagValueConversion)
+
+	d, e := bag.DurationE("duration")
+	assert.NoError(t, e)
+	assert.Equal(t, time.Second, d)
+
+	d, e = bag.DurationE("ms")
+	assert.NoError(t, e)
+	assert.Equal(t, 250*time.Millisecond, d)
+
+	u, e := bag.UintE("nested.field")
+	assert.NoError(t, e)
+	assert.Equal(t, uint(5), u)
+
+	var flamErr flam.Error
+	_, e = bag.Int8E("float")
+	assert.ErrorAs(t, e, &flamErr)
+	assert.Equal(t, "float", flamErr.Get("path"))
+	assert.Equal(t, "int8", flamErr.Get("type"))
+}
+
+func Test_Bag_Coerced(t *testing.T) {
+	bag := flam.Bag{
+		"int":      "123",
+		"float":    float64(1),
+		"bool":     "false",
+		"duration": 10,
+		"invalid":  "abc",
+		"nested":   flam.Bag{"field": "456"},
+	}
+	coerced := bag.Coerced()
+
+	assert.True(t, coerced.Has("int"))
+	assert.Equal(t, "123", coerced.Get("int"))
+	assert.Equal(t, 123, coerced.Int("int"))
+	assert.Equal(t, int8(1), coerced.Int8("float"))
+	assert.Equal(t, int16(1), coerced.Int16("float"))
+	assert.Equal(t, int32(123), coerced.Int32("int"))
+	assert.Equal(t, int64(123), coerced.Int64("int"))
+	assert.Equal(t, uint(123), coerced.Uint("int"))
+	assert.Equal(t, uint8(1), coerced.Uint8("float"))
+	assert.Equal(t, uint16(123), coerced.Uint16("int"))
+	assert.Equal(t, uint32(123), coerced.Uint32("int"))
+	assert.Equal(t, uint64(123), coerced.Uint64("int"))
+	assert.Equal(t, float32(123), coerced.Float32("int"))
+	assert.Equal(t, float64(123), coerced.Float64("int"))
+	assert.Equal(t, "1", coerced.String("float"))
+	assert.False(t, coerced.Bool("bool", true))
+	assert.Equal(t, 10*time.Millisecond, coerced.Duration("duration"))
+	assert.Equal(t, 456, coerced.Bag("nested").Int("field"))
+
+	assert.Equal(t, 0, coerced.Int("invalid"))
+	assert.Equal(t, 789, coerced.Int("invalid", 789))
+	assert.Equal(t, 789, coerced.Int("missing", 789))
+	assert.Equal(t, 789, bag.Int("int", 789))
+}
@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+func Test_ParseJSON(t *testing.T) {
+	scenarios := []struct {
+		test     string
+		data     string
+		expected flam.Bag
+		hasErr   bool
+	}{
+		{
+			test:     "should parse an empty object",
+			data:     `{}`,
+			expected: flam.Bag{},
+		},
+		{
+			test:     "should parse a null document",
+			data:     `null`,
+			expected: flam.Bag{},
+		},
+		{
+			test: "should normalise the nested objects into bags",
+			data: `{"a": {"b": 1}, "c": [{"d": "e"}, 2]}`,
+			expected: flam.Bag{
+				"a": flam.Bag{"b": float64(1)},
+				"c": []any{flam.Bag{"d": "e"}, float64(2)},
+			},
+		},
+		{
+			test:   "should return an error for a non object document",
+			data:   `[1, 2]`,
+			hasErr: true,
+		},
+		{
+			test:   "should return an error for an invalid document",
+			data:   `{`,
+			hasErr: true,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.test, func(t *testing.T) {
+			bag, e := flam.ParseJSON([]byte(scenario.data))
+
+			if scenario.hasErr {
+				assert.Error(t, e)
+				assert.Nil(t, bag)
+				return
+			}
+
+			assert.NoError(t, e)
+			assert.Equal(t, scenario.expected, bag)
+		})
+	}
+}
+
+func Test_ParseYAML(t *testing.T) {
+	scenarios := []struct {
+		test     string
+		data     string
+		expected flam.Bag
+		hasErr   bool
+	}{
+		{
+			test:     "should parse an empty document",
+			data:     ``,
+			expected: flam.Bag{},
+		},
+		{
+			test: "should normalise the nested mappings into bags",
+			data: "a:\n  b: 1\nc:\n  - d: e\n  - 2\n",
+			expected: flam.Bag{
+				"a": flam.Bag{"b": 1},
+				"c": []any{flam.Bag{"d": "e"}, 2},
+			},
+		},
+		{
+			test:     "should stringify non string keys",
+			data:     "a:\n  1: one\n  true: yes\n",
+			expected: flam.Bag{"a": flam.Bag{"1": "one", "true": "yes"}},
+		},
+		{
+			test:   "should return an error for a non mapping document",
+			data:   "- 1\n- 2\n",
+			hasErr: true,
+		},
+		{
+			test:   "should return an error for an invalid document",
+			data:   "a: [",
+			hasErr: true,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.test, func(t *testing.T) {
+			bag, e := flam.ParseYAML([]byte(scenario.data))
+
+			if scenario.hasErr {
+				assert.Error(t, e)
+				assert.Nil(t, bag)
+				return
+			}
+
+			assert.NoError(t, e)
+			assert.Equal(t, scenario.expected, bag)
+		})
+	}
+}
+
+func Test_Bag_JSON(t *testing.T) {
+	t.Run("should marshal a bag with nested bags", func(t *testing.T) {
+		bag := flam.Bag{"a": flam.Bag{"b": 1}, "c": &flam.Bag{"d": []any{flam.Bag{"e": true}}}}
+
+		data, e := json.Marshal(bag)
+		require.NoError(t, e)
+		assert.JSONEq(t, `{"a": {"b": 1}, "c": {"d": [{"e": true}]}}`, string(data))
+	})
+
+	t.Run("should unmarshal a bag field of a struct", func(t *testing.T) {
+		target := struct {
+			Config flam.Bag `json:"config"`
+		}{}
+
+		require.NoError(t, json.Unmarshal([]byte(`{"config": {"a": {"b": "c"}}}`), &target))
+		assert.Equal(t, flam.Bag{"b": "c"}, target.Config.Bag("a"))
+		assert.Equal(t, "c", target.Config.String("a.b"))
+	})
+
+	t.Run("should replace the content of the bag", func(t *testing.T) {
+		bag := flam.Bag{"old": 1}
+
+		require.NoError(t, json.Unmarshal([]byte(`{"new": 2}`), &bag))
+		assert.Equal(t, flam.Bag{"new": float64(2)}, bag)
+	})
+}
+
+func Test_Bag_YAML(t *testing.T) {
+	t.Run("should marshal a bag with nested bags", func(t *testing.T) {
+		bag := flam.Bag{"b": flam.Bag{"c": 1}, "a": []any{flam.Bag{"d": "e"}}}
+
+		data, e := yaml.Marshal(bag)
+		require.NoError(t, e)
+		assert.Equal(t, "a:\n    - d: e\nb:\n    c: 1\n", string(data))
+	})
+
+	t.Run("should unmarshal a bag field of a struct", func(t *testing.T) {
+		target := struct {
+			Config flam.Bag `yaml:"config"`
+		}{}
+
+		require.NoError(t, yaml.Unmarshal([]byte("config:\n  a:\n    b: c\n"), &target))
+		assert.Equal(t, flam.Bag{"b": "c"}, target.Config.Bag("a"))
+		assert.Equal(t, "c", target.Config.String("a.b"))
+	})
+
+	t.Run("should round trip a bag", func(t *testing.T) {
+		bag := flam.Bag{"a": flam.Bag{"b": []any{1, "two", flam.Bag{"c": 3.5}}}}
+
+		data, e := yaml.Marshal(bag)
+		require.NoError(t, e)
+
+		result, e := flam.ParseYAML(data)
+		require.NoError(t, e)
+		assert.Equal(t, bag, result)
+	})
+}
@@ -166,6 +166,58 @@ func Test_Bag_Has(t *testing.T) {
 			bag:      flam.Bag{"a": &flam.Bag{"b": 1}},
 			path:     "a.c",
 			expected: false,
+		}, {
+			test:     "should return true for a list index",
+			bag:      flam.Bag{"a": []any{flam.Bag{"b": 1}}},
+			path:     "a.0.b",
+			expected: true,
+		},
+		{
+			test:     "should return true for a bracket list index",
+			bag:      flam.Bag{"a": []any{flam.Bag{"b": 1}}},
+			path:     "a[0].b",
+			expected: true,
+		},
+		{
+			test:     "should return false for an out of range list index",
+			bag:      flam.Bag{"a": []any{1}},
+			path:     "a[1]",
+			expected: false,
+		},
+		{
+			test:     "should return false for a bracket index on a bag",
+			bag:      flam.Bag{"a": flam.Bag{"0": 1}},
+			path:     "a[0]",
+			expected: false,
+		},
+		{
+			test:     "should return false for an append index",
+			bag:      flam.Bag{"a": []any{1}},
+			path:     "a[]",
+			expected: false,
+		},
+		{
+			test:     "should return false for a malformed bracket index",
+			bag:      flam.Bag{"a": []any{1}},
+			path:     "a[x]",
+			expected: false,
+		}, {
+			test:     "should return true for a quoted key with dots",
+			bag:      flam.Bag{"a": flam.Bag{"b.c": flam.Bag{"d": 1}}},
+			path:     `a."b.c".d`,
+			expected: true,
+		},
+		{
+			test:     "should return false for a quoted index on a list",
+			bag:      flam.Bag{"a": []any{1}},
+			path:     `a."0"`,
+			expected: false,
+		},
+		{
+			test:     "should return false for an unterminated quoted key",
+			bag:      flam.Bag{"a": flam.Bag{"b": 1}},
+			path:     `a."b`,
+			expected: false,
 		},
 	}
 
@@ -246,6 +298,88 @@ func Test_Bag_Get(t *testing.T) {
 			path:     "field.nonexistent",
 			def:      []any{"default"},
 			expected: "default",
+		}, {
+			test:     "should return a list element by dotted index",
+			bag:      flam.Bag{"servers": []any{flam.Bag{"host": "a"}, flam.Bag{"host": "b"}}},
+			path:     "servers.1.host",
+			def:      nil,
+			expected: "b",
+		},
+		{
+			test:     "should return a list element by bracket index",
+			bag:      flam.Bag{"servers": []any{flam.Bag{"host": "a"}, flam.Bag{"host": "b"}}},
+			path:     "servers[0].host",
+			def:      nil,
+			expected: "a",
+		},
+		{
+			test:     "should return a list element by negative index",
+			bag:      flam.Bag{"servers": []any{flam.Bag{"host": "a"}, flam.Bag{"host": "b"}}},
+			path:     "servers[-1].host",
+			def:      nil,
+			expected: "b",
+		},
+		{
+			test:     "should return a nested list element",
+			bag:      flam.Bag{"matrix": []any{[]any{1, 2}, []any{3, 4}}},
+			path:     "matrix[1][0]",
+			def:      nil,
+			expected: 3,
+		},
+		{
+			test:     "should return a numeric key of a bag",
+			bag:      flam.Bag{"codes": flam.Bag{"0": "zero"}},
+			path:     "codes.0",
+			def:      nil,
+			expected: "zero",
+		},
+		{
+			test:     "should return the default value for an out of range index",
+			bag:      flam.Bag{"servers": []any{1}},
+			path:     "servers[-2]",
+			def:      []any{"default"},
+			expected: "default",
+		}, {
+			test:     "should return a value for a quoted key with dots",
+			bag:      flam.Bag{"metrics": flam.Bag{"http.requests": 10}},
+			path:     `metrics."http.requests"`,
+			def:      nil,
+			expected: 10,
+		},
+		{
+			test:     "should return a value for an escaped key with dots",
+			bag:      flam.Bag{"metrics": flam.Bag{"http.requests": 10}},
+			path:     `metrics.http\.requests`,
+			def:      nil,
+			expected: 10,
+		},
+		{
+			test:     "should return a value for a quoted key with escaped quotes",
+			bag:      flam.Bag{"a": flam.Bag{`say "hi"`: 1}},
+			path:     `a."say \"hi\""`,
+			def:      nil,
+			expected: 1,
+		},
+		{
+			test:     "should return a value for an escaped bracket",
+			bag:      flam.Bag{"a[0]": 1},
+			path:     `a\[0\]`,
+			def:      nil,
+			expected: 1,
+		},
+		{
+			test:     "should return a list element after a quoted key",
+			bag:      flam.Bag{"a.b": []any{1, 2}},
+			path:     `"a.b"[1]`,
+			def:      nil,
+			expected: 2,
+		},
+		{
+			test:     "should return the default value for a dangling escape",
+			bag:      flam.Bag{"a": 1},
+			path:     `a\`,
+			def:      []any{"default"},
+			expected: "default",
 		},
 	}
 
@@ -2062,6 +2196,95 @@ func Test_Bag_Set(t *testing.T) {
 			path:     "a.b",
 			value:    "hello",
 			expected: flam.Bag{"a": flam.Bag{"b": "hello"}},
+		}, {
+			test:     "should set a list element by dotted index",
+			bag:      flam.Bag{"servers": []any{flam.Bag{"host": "a"}}},
+			path:     "servers.0.host",
+			value:    "b",
+			expected: flam.Bag{"servers": []any{flam.Bag{"host": "b"}}},
+		},
+		{
+			test:     "should set a list element by negative bracket index",
+			bag:      flam.Bag{"servers": []any{1, 2}},
+			path:     "servers[-1]",
+			value:    3,
+			expected: flam.Bag{"servers": []any{1, 3}},
+		},
+		{
+			test:     "should append a value to a list",
+			bag:      flam.Bag{"servers": []any{1}},
+			path:     "servers[]",
+			value:    2,
+			expected: flam.Bag{"servers": []any{1, 2}},
+		},
+		{
+			test:     "should create a list to append a bag",
+			bag:      flam.Bag{},
+			path:     "servers[].host",
+			value:    "a",
+			expected: flam.Bag{"servers": []any{flam.Bag{"host": "a"}}},
+		},
+		{
+			test:     "should set a value through a pointer to a bag",
+			bag:      flam.Bag{"a": &flam.Bag{"b": 1}},
+			path:     "a.b",
+			value:    2,
+			expected: flam.Bag{"a": &flam.Bag{"b": 2}},
+		},
+		{
+			test:        "should return an error for an out of range index",
+			bag:         flam.Bag{"servers": []any{1}},
+			path:        "servers[1]",
+			value:       2,
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+		{
+			test:        "should return an error for a malformed index",
+			bag:         flam.Bag{},
+			path:        "servers[0",
+			value:       2,
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+		{
+			test:        "should return an error for a top level index",
+			bag:         flam.Bag{},
+			path:        "[]",
+			value:       2,
+			expectedErr: flam.ErrBagInvalidPath,
+		}, {
+			test:     "should set a value for a quoted key with dots",
+			bag:      flam.Bag{},
+			path:     `hosts."example.com".port`,
+			value:    80,
+			expected: flam.Bag{"hosts": flam.Bag{"example.com": flam.Bag{"port": 80}}},
+		},
+		{
+			test:     "should set a value for an escaped key with dots",
+			bag:      flam.Bag{},
+			path:     `hosts.example\.com`,
+			value:    80,
+			expected: flam.Bag{"hosts": flam.Bag{"example.com": 80}},
+		},
+		{
+			test:     "should set a value for an empty quoted key",
+			bag:      flam.Bag{},
+			path:     `a.""`,
+			value:    1,
+			expected: flam.Bag{"a": flam.Bag{"": 1}},
+		},
+		{
+			test:     "should replace a list with a bag on a quoted numeric key",
+			bag:      flam.Bag{"a": []any{1}},
+			path:     `a."0"`,
+			value:    2,
+			expected: flam.Bag{"a": flam.Bag{"0": 2}},
+		},
+		{
+			test:        "should return an error for text after a quoted key",
+			bag:         flam.Bag{},
+			path:        `"a"b`,
+			value:       1,
+			expectedErr: flam.ErrBagInvalidPath,
 		},
 	}
 
@@ -2080,6 +2303,363 @@ func Test_Bag_Set(t *testing.T) {
 	}
 }
 
+func Test_Bag_Delete(t *testing.T) {
+	scenarios := []struct {
+		test        string
+		bag         flam.Bag
+		path        string
+		prune       bool
+		expected    flam.Bag
+		expectedErr error
+	}{
+		{
+			test:     "should delete a value at the top level",
+			bag:      flam.Bag{"a": 1, "b": 2},
+			path:     "a",
+			expected: flam.Bag{"b": 2},
+		},
+		{
+			test:     "should delete a nested value",
+			bag:      flam.Bag{"a": flam.Bag{"b": 1, "c": 2}},
+			path:     "a.b",
+			expected: flam.Bag{"a": flam.Bag{"c": 2}},
+		},
+		{
+			test:     "should delete a value through a pointer to a bag",
+			bag:      flam.Bag{"a": &flam.Bag{"b": 1}},
+			path:     "a.b",
+			expected: flam.Bag{"a": &flam.Bag{}},
+		},
+		{
+			test:     "should keep emptied bags without pruning",
+			bag:      flam.Bag{"a": flam.Bag{"b": flam.Bag{"c": 1}}},
+			path:     "a.b.c",
+			expected: flam.Bag{"a": flam.Bag{"b": flam.Bag{}}},
+		},
+		{
+			test:     "should prune emptied bags",
+			bag:      flam.Bag{"a": flam.Bag{"b": flam.Bag{"c": 1}}, "d": 2},
+			path:     "a.b.c",
+			prune:    true,
+			expected: flam.Bag{"d": 2},
+		},
+		{
+			test:     "should only prune the emptied bags",
+			bag:      flam.Bag{"a": flam.Bag{"b": flam.Bag{"c": 1}, "d": 2}},
+			path:     "a.b.c",
+			prune:    true,
+			expected: flam.Bag{"a": flam.Bag{"d": 2}},
+		},
+		{
+			test:     "should delete a list element",
+			bag:      flam.Bag{"a": []any{1, 2, 3}},
+			path:     "a[1]",
+			expected: flam.Bag{"a": []any{1, 3}},
+		},
+		{
+			test:     "should delete a value inside a list element",
+			bag:      flam.Bag{"a": []any{flam.Bag{"b": 1, "c": 2}}},
+			path:     "a.-1.b",
+			expected: flam.Bag{"a": []any{flam.Bag{"c": 2}}},
+		},
+		{
+			test:     "should delete a quoted key",
+			bag:      flam.Bag{"a": flam.Bag{"b.c": 1}},
+			path:     `a."b.c"`,
+			prune:    true,
+			expected: flam.Bag{},
+		},
+		{
+			test:        "should return an error for an empty path",
+			bag:         flam.Bag{"a": 1},
+			path:        "",
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+		{
+			test:        "should return an error for a missing key",
+			bag:         flam.Bag{"a": flam.Bag{}},
+			path:        "a.b",
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+		{
+			test:        "should return an error for an out of range index",
+			bag:         flam.Bag{"a": []any{1}},
+			path:        "a[1]",
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+		{
+			test:        "should return an error for a path through a scalar",
+			bag:         flam.Bag{"a": 1},
+			path:        "a.b",
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.test, func(t *testing.T) {
+			e := scenario.bag.Delete(scenario.path, scenario.prune)
+
+			if scenario.expectedErr != nil {
+				assert.ErrorIs(t, e, scenario.expectedErr)
+				return
+			}
+
+			assert.NoError(t, e)
+			assert.Equal(t, scenario.expected, scenario.bag)
+		})
+	}
+}
+
+func Test_Bag_Move(t *testing.T) {
+	scenarios := []struct {
+		test        string
+		bag         flam.Bag
+		from        string
+		to          string
+		prune       bool
+		expected    flam.Bag
+		expectedErr error
+	}{
+		{
+			test:     "should rename a key",
+			bag:      flam.Bag{"a": 1},
+			from:     "a",
+			to:       "b",
+			expected: flam.Bag{"b": 1},
+		},
+		{
+			test:     "should move a subtree",
+			bag:      flam.Bag{"a": flam.Bag{"b": flam.Bag{"c": 1}}},
+			from:     "a.b",
+			to:       "d",
+			expected: flam.Bag{"a": flam.Bag{}, "d": flam.Bag{"c": 1}},
+		},
+		{
+			test:     "should move a subtree and prune the emptied bags",
+			bag:      flam.Bag{"a": flam.Bag{"b": flam.Bag{"c": 1}}},
+			from:     "a.b",
+			to:       "d",
+			prune:    true,
+			expected: flam.Bag{"d": flam.Bag{"c": 1}},
+		},
+		{
+			test:     "should move a subtree to its parent",
+			bag:      flam.Bag{"a": flam.Bag{"b": flam.Bag{"b": 1}}},
+			from:     "a.b",
+			to:       "a",
+			expected: flam.Bag{"a": flam.Bag{"b": 1}},
+		},
+		{
+			test:     "should move a list element",
+			bag:      flam.Bag{"a": []any{1, 2}},
+			from:     "a[0]",
+			to:       "b",
+			expected: flam.Bag{"a": []any{2}, "b": 1},
+		},
+		{
+			test:        "should return an error for a missing source",
+			bag:         flam.Bag{"a": 1},
+			from:        "b",
+			to:          "c",
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+		{
+			test:        "should return an error for an empty destination",
+			bag:         flam.Bag{"a": 1},
+			from:        "a",
+			to:          "",
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+		{
+			test:        "should return an error when moving a subtree into itself",
+			bag:         flam.Bag{"a": flam.Bag{"b": 1}},
+			from:        "a",
+			to:          "a.c",
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+		{
+			test:        "should return an error for an out of range destination",
+			bag:         flam.Bag{"a": 1, "b": []any{2}},
+			from:        "a",
+			to:          "b[1]",
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+		{
+			test:        "should leave the bag untouched when the destination is a scalar",
+			bag:         flam.Bag{"list": []any{"a", "b", "c"}, "x": "scalar"},
+			from:        "list.0",
+			to:          "x[0]",
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+		{
+			test:        "should leave the bag untouched when the source removal shifts the destination",
+			bag:         flam.Bag{"list": []any{"a", "b"}},
+			from:        "list[0]",
+			to:          "list[1]",
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.test, func(t *testing.T) {
+			original := scenario.bag.Clone()
+			e := scenario.bag.Move(scenario.from, scenario.to, scenario.prune)
+
+			if scenario.expectedErr != nil {
+				assert.ErrorIs(t, e, scenario.expectedErr)
+				assert.Equal(t, original, scenario.bag)
+				return
+			}
+
+			assert.NoError(t, e)
+			assert.Equal(t, scenario.expected, scenario.bag)
+		})
+	}
+}
+
+func Test_Bag_Copy(t *testing.T) {
+	t.Run("should copy a deep clone of a subtree", func(t *testing.T) {
+		bag := flam.Bag{"a": flam.Bag{"b": []any{flam.Bag{"c": 1}}}}
+
+		require.NoError(t, bag.Copy("a", "d.e"))
+		assert.Equal(t, flam.Bag{
+			"a": flam.Bag{"b": []any{flam.Bag{"c": 1}}},
+			"d": flam.Bag{"e": flam.Bag{"b": []any{flam.Bag{"c": 1}}}},
+		}, bag)
+
+		require.NoError(t, bag.Set("d.e.b[0].c", 2))
+		assert.Equal(t, 1, bag.Get("a.b[0].c"))
+	})
+
+	t.Run("should copy a list element", func(t *testing.T) {
+		bag := flam.Bag{"a": []any{1, 2}}
+
+		require.NoError(t, bag.Copy("a[-1]", "a[]"))
+		assert.Equal(t, flam.Bag{"a": []any{1, 2, 2}}, bag)
+	})
+
+	t.Run("should return an error for a missing source", func(t *testing.T) {
+		bag := flam.Bag{}
+		assert.ErrorIs(t, bag.Copy("a", "b"), flam.ErrBagInvalidPath)
+	})
+
+	t.Run("should return an error for an invalid destination", func(t *testing.T) {
+		bag := flam.Bag{"a": 1}
+		assert.ErrorIs(t, bag.Copy("a", ""), flam.ErrBagInvalidPath)
+	})
+}
+
+func Test_Bag_Keys(t *testing.T) {
+	scenarios := []struct {
+		test        string
+		bag         flam.Bag
+		path        string
+		expected    []string
+		expectedErr error
+	}{
+		{
+			test:     "should return the sorted top level keys",
+			bag:      flam.Bag{"b": 1, "a": 2, "c": 3},
+			path:     "",
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			test:     "should return the keys of a nested bag",
+			bag:      flam.Bag{"a": flam.Bag{"y": 1, "x": 2}},
+			path:     "a",
+			expected: []string{"x", "y"},
+		},
+		{
+			test:     "should return the keys of a pointer to a bag",
+			bag:      flam.Bag{"a": &flam.Bag{"x": 1}},
+			path:     "a",
+			expected: []string{"x"},
+		},
+		{
+			test:     "should return the keys of a bag inside a list",
+			bag:      flam.Bag{"a": []any{flam.Bag{"x": 1}}},
+			path:     "a[0]",
+			expected: []string{"x"},
+		},
+		{
+			test:     "should return nil for an empty bag",
+			bag:      flam.Bag{"a": flam.Bag{}},
+			path:     "a",
+			expected: nil,
+		},
+		{
+			test:        "should return an error for a missing path",
+			bag:         flam.Bag{},
+			path:        "a",
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+		{
+			test:        "should return an error for a non bag value",
+			bag:         flam.Bag{"a": []any{1}},
+			path:        "a",
+			expectedErr: flam.ErrBagInvalidPath,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.test, func(t *testing.T) {
+			keys, e := scenario.bag.Keys(scenario.path)
+
+			if scenario.expectedErr != nil {
+				assert.ErrorIs(t, e, scenario.expectedErr)
+				return
+			}
+
+			assert.NoError(t, e)
+			assert.Equal(t, scenario.expected, keys)
+		})
+	}
+}
+
+func Test_BagPathJoin(t *testing.T) {
+	scenarios := []struct {
+		test     string
+		parts    []string
+		expected string
+	}{
+		{
+			test:     "should return an empty path for no parts",
+			parts:    nil,
+			expected: "",
+		},
+		{
+			test:     "should join simple parts",
+			parts:    []string{"a", "b", "0"},
+			expected: "a.b.0",
+		},
+		{
+			test:     "should escape the path special characters",
+			parts:    []string{"hosts", "example.com", `a[0]`, `say "hi"`, `c:\\`},
+			expected: `hosts.example\.com.a\[0\].say \"hi\".c:\\\\`,
+		},
+		{
+			test:     "should quote empty parts",
+			parts:    []string{"a", ""},
+			expected: `a.""`,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.test, func(t *testing.T) {
+			assert.Equal(t, scenario.expected, flam.BagPathJoin(scenario.parts...))
+		})
+	}
+
+	t.Run("should produce paths addressing the original keys", func(t *testing.T) {
+		bag := flam.Bag{}
+		path := flam.BagPathJoin("hosts", "example.com", `a[0]`, `say "hi"`, "")
+
+		require.NoError(t, bag.Set(path, 123))
+		assert.Equal(t, 123, bag.Get(path))
+		assert.Equal(t, flam.Bag{"hosts": flam.Bag{"example.com": flam.Bag{"a[0]": flam.Bag{`say "hi"`: flam.Bag{"": 123}}}}}, bag)
+	})
+}
+
 func Test_Bag_Merge(t *testing.T) {
 	scenarios := []struct {
 		test     string
@@ -2241,6 +2821,13 @@ func Test_Bag_Populate(t *testing.T) {
 				target:      &simpleStruct{},
 				expectedErr: flam.ErrBagInvalidPath,
 			},
+			{
+				test:     "should populate a struct from a list element",
+				bag:      flam.Bag{"config": []any{flam.Bag{"field": 1}, flam.Bag{"field": 2}}},
+				path:     "config[1]",
+				target:   &simpleStruct{},
+				expected: &simpleStruct{Field: 2},
+			},
 			{
 				test: "should populate a complex struct from a nested path",
 				bag: flam.Bag{
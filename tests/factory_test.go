@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -14,6 +15,16 @@ import (
 
 type testResource struct{}
 
+type testHealthResource struct {
+	e error
+}
+
+func (r *testHealthResource) Check(
+	_ context.Context,
+) error {
+	return r.e
+}
+
 func Test_Factory_NewFactory(t *testing.T) {
 	t.Run("should return ErrNilReference when config is nil", func(t *testing.T) {
 		factory, e := flam.NewFactory[flam.Resource](nil, "path", nil, nil)
@@ -72,6 +83,42 @@ func Test_Factory_Close(t *testing.T) {
 		assert.ErrorIs(t, factory.Close(), expectedErr)
 	})
 
+	t.Run("should close all closers and aggregate the errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expectedErr1 := errors.New("close error 1")
+		closer1 := mocks.NewCloser(ctrl)
+		closer1.EXPECT().Close().Return(expectedErr1).Times(1)
+
+		closer2 := mocks.NewCloser(ctrl)
+		closer2.EXPECT().Close().Return(nil).Times(1)
+
+		expectedErr3 := errors.New("close error 3")
+		closer3 := mocks.NewCloser(ctrl)
+		closer3.EXPECT().Close().Return(expectedErr3).Times(1)
+
+		config := flam.Bag{}
+		factoryConfig := mocks.NewFactoryConfig(ctrl)
+		factoryConfig.EXPECT().Get("path").Return(config).Times(3)
+
+		factory, e := flam.NewFactory[flam.Resource](nil, "path", factoryConfig, nil)
+		require.NotNil(t, factory)
+		require.NoError(t, e)
+
+		assert.NoError(t, factory.Add("resource1", closer1))
+		assert.NoError(t, factory.Add("resource2", closer2))
+		assert.NoError(t, factory.Add("resource3", closer3))
+
+		e = factory.Close()
+		assert.ErrorIs(t, e, expectedErr1)
+		assert.ErrorIs(t, e, expectedErr3)
+
+		var flamErr flam.Error
+		require.ErrorAs(t, e, &flamErr)
+		assert.Equal(t, "resource1", flamErr.Get("resource"))
+	})
+
 	t.Run("should not fail with non-closable resources", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -89,6 +136,38 @@ func Test_Factory_Close(t *testing.T) {
 	})
 }
 
+func Test_Factory_HealthChecks(t *testing.T) {
+	t.Run("should list a health check per checkable resource", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		factoryConfig := mocks.NewFactoryConfig(ctrl)
+		factoryConfig.EXPECT().Get("path").Return(flam.Bag{}).Times(3)
+
+		factory, e := flam.NewFactory[flam.Resource](nil, "path", factoryConfig, nil)
+		require.NotNil(t, factory)
+		require.NoError(t, e)
+
+		expectedErr := errors.New("check error")
+		assert.NoError(t, factory.Add("resource2", &testHealthResource{e: expectedErr}))
+		assert.NoError(t, factory.Add("resource1", &testHealthResource{}))
+		assert.NoError(t, factory.Add("resource3", &testResource{}))
+
+		checks := factory.HealthChecks()
+		require.Len(t, checks, 2)
+		assert.Equal(t, "resource1", checks[0].Name)
+		assert.NoError(t, checks[0].Check(context.Background()))
+		assert.Equal(t, "resource2", checks[1].Name)
+
+		e = checks[1].Check(context.Background())
+		assert.ErrorIs(t, e, expectedErr)
+
+		var flamErr flam.Error
+		require.ErrorAs(t, e, &flamErr)
+		assert.Equal(t, "resource2", flamErr.Get("resource"))
+	})
+}
+
 func Test_Factory_List(t *testing.T) {
 	t.Run("should return a sorted list of ids from config and added entries", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
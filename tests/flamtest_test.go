@@ -0,0 +1,148 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/happyhippyhippo/flam"
+	"github.com/happyhippyhippo/flam/flamtest"
+)
+
+func Test_Flamtest_NewTestApplication(t *testing.T) {
+	t.Run("should boot the application", func(t *testing.T) {
+		recorder := flamtest.NewRecorder()
+		app := flamtest.NewTestApplication(t,
+			recorder.Provider("logger", "config"),
+			recorder.Provider("config"))
+
+		assert.Equal(t, flam.ApplicationBooted, app.State())
+		recorder.AssertOrder(t,
+			"register:logger",
+			"register:config",
+			"boot:config",
+			"boot:logger")
+	})
+
+	t.Run("should close the application on cleanup", func(t *testing.T) {
+		recorder := flamtest.NewRecorder()
+		t.Run("application", func(t *testing.T) {
+			flamtest.NewTestApplication(t,
+				recorder.Provider("logger", "config"),
+				recorder.Provider("config"))
+		})
+
+		recorder.AssertOrder(t,
+			"register:logger",
+			"register:config",
+			"boot:config",
+			"boot:logger",
+			"close:logger",
+			"close:config")
+	})
+}
+
+func Test_Flamtest_PrepareTestApplication(t *testing.T) {
+	t.Run("should register the providers without booting", func(t *testing.T) {
+		recorder := flamtest.NewRecorder()
+		app := flamtest.PrepareTestApplication(t, recorder.Provider("config"))
+
+		assert.Equal(t, flam.ApplicationRegistered, app.State())
+		recorder.AssertOrder(t, "register:config")
+	})
+
+	t.Run("should allow replacing a type consumed during boot", func(t *testing.T) {
+		var booted string
+		app := flamtest.PrepareTestApplication(t, &testGraphProvider{
+			testProvider: testProvider{
+				id: "consumer",
+				boot: func(container *dig.Container) error {
+					return container.Invoke(func(version *testVersion) {
+						booted = version.value
+					})
+				},
+			},
+			register: func(container *dig.Container) error {
+				return container.Provide(func() *testVersion { return &testVersion{value: "real"} })
+			},
+		})
+
+		require.NoError(t, flamtest.Replace(app, &testVersion{value: "fake"}))
+		require.NoError(t, app.Boot())
+		assert.Equal(t, "fake", booted)
+	})
+}
+
+func Test_Flamtest_Replace(t *testing.T) {
+	t.Run("should return ErrNilReference when app is nil", func(t *testing.T) {
+		assert.ErrorIs(t, flamtest.Replace(nil, &testVersion{}), flam.ErrNilReference)
+	})
+
+	t.Run("should return the provide error that is not a conflict", func(t *testing.T) {
+		app := flamtest.NewTestApplication(t)
+
+		e := flamtest.Replace[error](app, errors.New("value"))
+		assert.ErrorContains(t, e, "must provide at least one non-error type")
+	})
+
+	t.Run("should provide a type that was not provided", func(t *testing.T) {
+		app := flamtest.NewTestApplication(t)
+		require.NoError(t, flamtest.Replace(app, &testVersion{value: "fake"}))
+
+		assert.NoError(t, app.Container().Invoke(func(version *testVersion) {
+			assert.Equal(t, "fake", version.value)
+		}))
+	})
+
+	t.Run("should override a provided type", func(t *testing.T) {
+		app := flamtest.NewTestApplication(t, &testReloadableProvider{
+			testProvider: testProvider{id: "provider"},
+			version:      "real",
+		})
+		require.NoError(t, flamtest.Replace(app, &testVersion{value: "fake"}))
+
+		assert.NoError(t, app.Container().Invoke(func(version *testVersion) {
+			assert.Equal(t, "fake", version.value)
+		}))
+		assert.NoError(t, app.Scope().Invoke(func(version *testVersion) {
+			assert.Equal(t, "fake", version.value)
+		}))
+	})
+
+	t.Run("should override a type replaced before", func(t *testing.T) {
+		app := flamtest.PrepareTestApplication(t, &testReloadableProvider{
+			testProvider: testProvider{id: "provider"},
+			version:      "real",
+		})
+		require.NoError(t, flamtest.Replace(app, &testVersion{value: "fake1"}))
+		require.NoError(t, flamtest.Replace(app, &testVersion{value: "fake2"}))
+		require.NoError(t, flamtest.Replace(app, &testVersion{value: "fake3"}))
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(version *testVersion) {
+			assert.Equal(t, "fake3", version.value)
+		}))
+	})
+
+	t.Run("should override a type it provided before", func(t *testing.T) {
+		app := flamtest.NewTestApplication(t)
+		require.NoError(t, flamtest.Replace(app, &testVersion{value: "fake1"}))
+		require.NoError(t, flamtest.Replace(app, &testVersion{value: "fake2"}))
+
+		assert.NoError(t, app.Container().Invoke(func(version *testVersion) {
+			assert.Equal(t, "fake2", version.value)
+		}))
+	})
+}
+
+func Test_Flamtest_Recorder(t *testing.T) {
+	recorder := flamtest.NewRecorder()
+	provider := recorder.Provider("provider")
+
+	require.NoError(t, provider.Register(dig.New()))
+	assert.Equal(t, []string{"register:provider"}, recorder.Entries())
+	assert.True(t, recorder.AssertOrder(t, "register:provider"))
+}
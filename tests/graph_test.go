@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+type testGraphConfig struct{}
+
+type testGraphLogger struct{}
+
+type testGraphProvider struct {
+	testProvider
+	register func(container *dig.Container) error
+}
+
+func (p *testGraphProvider) Register(
+	container *dig.Container,
+) error {
+	return p.register(container)
+}
+
+func Test_Application_Graph(t *testing.T) {
+	app := flam.NewApplication(flam.WithGraphTracking())
+	require.NoError(t, app.Register(&testGraphProvider{
+		testProvider: testProvider{id: "config"},
+		register: func(container *dig.Container) error {
+			return container.Provide(func() *testGraphConfig { return &testGraphConfig{} })
+		},
+	}))
+	require.NoError(t, app.Register(&testProvider{id: "empty"}))
+	require.NoError(t, app.Register(&testGraphProvider{
+		testProvider: testProvider{id: "logger"},
+		register: func(container *dig.Container) error {
+			return container.Provide(func(*testGraphConfig) *testGraphLogger { return &testGraphLogger{} })
+		},
+	}))
+	require.NoError(t, app.Container().Provide(func() string { return "" }))
+
+	graph, e := app.Graph()
+	require.NoError(t, e)
+	require.Len(t, graph.Constructors, 3)
+
+	assert.Equal(t, "config", graph.Constructors[0].Provider)
+	assert.Equal(t, "github.com/happyhippyhippo/flam/tests", graph.Constructors[0].Package)
+	assert.Empty(t, graph.Constructors[0].Params)
+	assert.Equal(t, []string{"*tests.testGraphConfig"}, graph.Constructors[0].Results)
+
+	assert.Equal(t, "logger", graph.Constructors[1].Provider)
+	assert.Equal(t, []string{"*tests.testGraphConfig"}, graph.Constructors[1].Params)
+	assert.Equal(t, []string{"*tests.testGraphLogger"}, graph.Constructors[1].Results)
+
+	assert.Equal(t, "", graph.Constructors[2].Provider)
+	assert.Equal(t, []string{"string"}, graph.Constructors[2].Results)
+
+	t.Run("should export the graph as json", func(t *testing.T) {
+		data, e := graph.JSON()
+		require.NoError(t, e)
+
+		decoded := flam.Graph{}
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, graph, decoded)
+	})
+
+	t.Run("should export the graph as dot", func(t *testing.T) {
+		dot := graph.DOT()
+		assert.Contains(t, dot, "label = \"config\";")
+		assert.Contains(t, dot, "label = \"logger\";")
+		assert.Contains(t, dot, "constructor_1 -> \"*tests.testGraphLogger\";")
+		assert.Contains(t, dot, "\"*tests.testGraphConfig\" -> constructor_1 [style=dashed];")
+	})
+
+	t.Run("should not attribute constructors without graph tracking", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testGraphProvider{
+			testProvider: testProvider{id: "config"},
+			register: func(container *dig.Container) error {
+				return container.Provide(func() *testGraphConfig { return &testGraphConfig{} })
+			},
+		}))
+
+		graph, e := app.Graph()
+		require.NoError(t, e)
+		require.Len(t, graph.Constructors, 1)
+		assert.Equal(t, "", graph.Constructors[0].Provider)
+		assert.Equal(t, []string{"*tests.testGraphConfig"}, graph.Constructors[0].Results)
+	})
+}
@@ -0,0 +1,199 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/happyhippyhippo/flam"
+	"github.com/happyhippyhippo/flam/tests/mocks"
+)
+
+type testHealthProvider struct {
+	testProvider
+	check func(ctx context.Context) error
+	live  func(ctx context.Context) error
+}
+
+func (p *testHealthProvider) Check(
+	ctx context.Context,
+) error {
+	return p.check(ctx)
+}
+
+func (p *testHealthProvider) CheckLiveness(
+	ctx context.Context,
+) error {
+	return p.live(ctx)
+}
+
+type testComponentHealthProvider struct {
+	testProvider
+	checks []flam.HealthCheck
+}
+
+func (p *testComponentHealthProvider) HealthChecks() []flam.HealthCheck {
+	return p.checks
+}
+
+type testFactoryProvider struct {
+	flam.Factory[flam.Resource]
+	id string
+}
+
+func (p *testFactoryProvider) Id() string {
+	return p.id
+}
+
+func (p *testFactoryProvider) Register(
+	_ *dig.Container,
+) error {
+	return nil
+}
+
+func Test_Application_Health(t *testing.T) {
+	up := func(context.Context) error { return nil }
+	down := func(context.Context) error { return errors.New("connection refused") }
+
+	t.Run("should report not ready before boot", func(t *testing.T) {
+		app := flam.NewApplication()
+
+		report := app.Health(context.Background(), flam.HealthReadiness)
+		assert.Equal(t, flam.HealthDown, report.Status)
+		require.Len(t, report.Components, 1)
+		assert.Equal(t, "application", report.Components[0].Name)
+		assert.Equal(t, flam.HealthDown, report.Components[0].Status)
+	})
+
+	t.Run("should report alive before boot", func(t *testing.T) {
+		app := flam.NewApplication()
+
+		assert.Equal(t, flam.HealthUp, app.Health(context.Background(), flam.HealthLiveness).Status)
+	})
+
+	t.Run("should report the provider components readiness", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testComponentHealthProvider{
+			testProvider: testProvider{id: "database"},
+			checks: []flam.HealthCheck{
+				{Name: "primary", Check: up},
+				{Name: "replica", Check: down},
+			},
+		}))
+		require.NoError(t, app.Boot())
+
+		report := app.Health(context.Background(), flam.HealthReadiness)
+		assert.Equal(t, flam.HealthDown, report.Status)
+		require.Len(t, report.Components, 3)
+		assert.Equal(t, "database.primary", report.Components[1].Name)
+		assert.Equal(t, flam.HealthUp, report.Components[1].Status)
+		assert.Equal(t, "database.replica", report.Components[2].Name)
+		assert.Equal(t, flam.HealthDown, report.Components[2].Status)
+		assert.Equal(t, "connection refused", report.Components[2].Error)
+
+		report = app.Health(context.Background(), flam.HealthLiveness)
+		assert.Equal(t, flam.HealthUp, report.Status)
+		assert.Len(t, report.Components, 1)
+	})
+
+	t.Run("should aggregate the providers readiness checks", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testHealthProvider{testProvider: testProvider{id: "cache"}, check: up, live: up}))
+		require.NoError(t, app.Register(&testHealthProvider{testProvider: testProvider{id: "db"}, check: down, live: up}))
+		require.NoError(t, app.Register(&testProvider{id: "other"}))
+		require.NoError(t, app.Boot())
+
+		report := app.Health(context.Background(), flam.HealthReadiness)
+		assert.Equal(t, "readiness", report.Probe)
+		assert.Equal(t, flam.HealthDown, report.Status)
+		require.Len(t, report.Components, 3)
+		assert.Equal(t, "cache", report.Components[1].Name)
+		assert.Equal(t, flam.HealthUp, report.Components[1].Status)
+		assert.Equal(t, "db", report.Components[2].Name)
+		assert.Equal(t, flam.HealthDown, report.Components[2].Status)
+		assert.Equal(t, "connection refused", report.Components[2].Error)
+
+		assert.Equal(t, flam.HealthUp, app.Health(context.Background(), flam.HealthLiveness).Status)
+	})
+}
+
+func Test_NewHealthHandler(t *testing.T) {
+	t.Run("should return ErrNilReference when app is nil", func(t *testing.T) {
+		handler, e := flam.NewHealthHandler(nil, flam.HealthReadiness)
+		assert.Nil(t, handler)
+		assert.ErrorIs(t, e, flam.ErrNilReference)
+	})
+
+	t.Run("should respond with service unavailable when down", func(t *testing.T) {
+		app := flam.NewApplication()
+		handler, e := flam.NewHealthHandler(app, flam.HealthReadiness)
+		require.NoError(t, e)
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+		assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	})
+
+	t.Run("should respond with the json report when up", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Boot())
+
+		handler, e := flam.NewHealthHandler(app, flam.HealthReadiness)
+		require.NoError(t, e)
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		report := flam.HealthReport{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &report))
+		assert.Equal(t, flam.HealthUp, report.Status)
+		assert.Equal(t, "readiness", report.Probe)
+	})
+
+	t.Run("should report the health of the resources of a factory provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		factoryConfig := mocks.NewFactoryConfig(ctrl)
+		factoryConfig.EXPECT().Get("databases").Return(flam.Bag{}).AnyTimes()
+
+		factory, e := flam.NewFactory[flam.Resource](nil, "databases", factoryConfig, nil)
+		require.NoError(t, e)
+		require.NoError(t, factory.Add("primary", &testHealthResource{}))
+		require.NoError(t, factory.Add("replica", &testHealthResource{e: errors.New("connection refused")}))
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testFactoryProvider{Factory: factory, id: "database"}))
+		require.NoError(t, app.Boot())
+
+		handler, e := flam.NewHealthHandler(app, flam.HealthReadiness)
+		require.NoError(t, e)
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+		report := flam.HealthReport{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &report))
+		assert.Equal(t, flam.HealthDown, report.Status)
+		require.Len(t, report.Components, 3)
+		assert.Equal(t, "database.primary", report.Components[1].Name)
+		assert.Equal(t, flam.HealthUp, report.Components[1].Status)
+		assert.Equal(t, "database.replica", report.Components[2].Name)
+		assert.Equal(t, flam.HealthDown, report.Components[2].Status)
+		assert.Contains(t, report.Components[2].Error, "connection refused")
+	})
+}
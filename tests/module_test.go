@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/happyhippyhippo/flam"
+	"github.com/happyhippyhippo/flam/flamtest"
+)
+
+func Test_Module(t *testing.T) {
+	t.Run("should expose the namespace and providers", func(t *testing.T) {
+		provider := &testProvider{id: "provider"}
+		module := flam.NewModule("module", provider)
+
+		assert.Equal(t, "module", module.Id())
+		assert.Equal(t, []flam.Provider{provider}, module.Providers())
+		assert.ErrorIs(t, module.Register(nil), flam.ErrUnexpectedModule)
+	})
+
+	t.Run("should register the nested providers as a unit", func(t *testing.T) {
+		recorder := flamtest.NewRecorder()
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(flam.NewModule("platform",
+			recorder.Provider("config"),
+			flam.NewModule("observability",
+				recorder.Provider("logger", "config"),
+				recorder.Provider("metrics", "logger")))))
+		require.NoError(t, app.Boot())
+
+		recorder.AssertOrder(t,
+			"register:config",
+			"register:logger",
+			"register:metrics",
+			"boot:config",
+			"boot:logger",
+			"boot:metrics")
+
+		infos := app.Providers()
+		require.Len(t, infos, 3)
+		assert.Equal(t, "platform", infos[0].Module)
+		assert.Equal(t, "platform/observability", infos[1].Module)
+		assert.Equal(t, "platform/observability", infos[2].Module)
+	})
+
+	t.Run("should return ErrNilReference on a nil module provider", func(t *testing.T) {
+		app := flam.NewApplication()
+
+		e := app.Register(flam.NewModule("platform", nil))
+		assert.ErrorIs(t, e, flam.ErrNilReference)
+	})
+
+	t.Run("should reject duplicate ids in the module tree naming both paths", func(t *testing.T) {
+		app := flam.NewApplication()
+
+		e := app.Register(flam.NewModule("platform",
+			&testProvider{id: "config"},
+			flam.NewModule("observability", &testProvider{id: "config"})))
+		assert.ErrorIs(t, e, flam.ErrDuplicateProvider)
+		assert.ErrorContains(t, e, "platform/config and platform/observability/config")
+		assert.Empty(t, app.Providers())
+	})
+
+	t.Run("should reject ids already registered naming both paths", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{id: "config"}))
+
+		e := app.Register(flam.NewModule("platform", &testProvider{id: "config"}))
+		assert.ErrorIs(t, e, flam.ErrDuplicateProvider)
+		assert.ErrorContains(t, e, "config and platform/config")
+	})
+
+	t.Run("should keep the module providers registered before a failing one", func(t *testing.T) {
+		expectedErr := errors.New("register error")
+		module := flam.NewModule("platform",
+			&testGraphProvider{
+				testProvider: testProvider{id: "config"},
+				register: func(container *dig.Container) error {
+					return container.Provide(func() *testGraphConfig { return &testGraphConfig{} })
+				},
+			},
+			&testGraphProvider{
+				testProvider: testProvider{id: "logger"},
+				register:     func(*dig.Container) error { return expectedErr },
+			})
+
+		app := flam.NewApplication()
+		e := app.Register(module)
+		assert.ErrorIs(t, e, expectedErr)
+		assert.ErrorContains(t, e, "provider(platform/logger)")
+
+		var typed flam.Error
+		require.ErrorAs(t, e, &typed)
+		assert.Equal(t, []string{"platform/config"}, typed.Get("registered"))
+
+		infos := app.Providers()
+		require.Len(t, infos, 1)
+		assert.Equal(t, "config", infos[0].Id)
+		assert.Equal(t, flam.ApplicationRegistered, app.State())
+
+		_, e = flam.Resolve[*testGraphConfig](app)
+		assert.NoError(t, e)
+
+		assert.ErrorIs(t, app.Register(module), flam.ErrDuplicateProvider)
+		require.NoError(t, app.Boot())
+		assert.Equal(t, flam.ProviderBooted, app.Providers()[0].State)
+	})
+
+	t.Run("should reject top level duplicate ids", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{id: "config"}))
+
+		assert.ErrorIs(t, app.Register(&testProvider{id: "config"}), flam.ErrDuplicateProvider)
+	})
+}
@@ -0,0 +1,212 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+func Test_NewConditionalProvider(t *testing.T) {
+	t.Run("should return nil reference error on nil provider", func(t *testing.T) {
+		conditional, e := flam.NewConditionalProvider(nil, flam.BagCondition(flam.Bag{}, "path", "value"))
+		assert.Nil(t, conditional)
+		assert.ErrorIs(t, e, flam.ErrNilReference)
+	})
+
+	t.Run("should return nil reference error on nil condition", func(t *testing.T) {
+		conditional, e := flam.NewConditionalProvider(&testProvider{id: "provider"}, nil)
+		assert.Nil(t, conditional)
+		assert.ErrorIs(t, e, flam.ErrNilReference)
+	})
+
+	t.Run("should return ErrUnexpectedModule when wrapping a module", func(t *testing.T) {
+		module := flam.NewModule("platform", &testProvider{id: "provider"})
+		conditional, e := flam.NewConditionalProvider(module, flam.BagCondition(flam.Bag{}, "path", "value"))
+		assert.Nil(t, conditional)
+		assert.ErrorIs(t, e, flam.ErrUnexpectedModule)
+	})
+
+	t.Run("should forward the wrapped provider identification and dependencies", func(t *testing.T) {
+		provider := &testProvider{id: "provider", dependencies: []string{"config"}}
+		conditional, e := flam.NewConditionalProvider(provider, flam.BagCondition(flam.Bag{}, "path", "value"))
+		require.NoError(t, e)
+
+		assert.Equal(t, "provider", conditional.Id())
+		assert.Equal(t, []string{"config"}, conditional.DependsOn())
+		assert.Same(t, provider, conditional.Provider())
+	})
+}
+
+func Test_Application_ConditionalProvider(t *testing.T) {
+	newProvider := func(registered, booted, closed *bool) *testGraphProvider {
+		return &testGraphProvider{
+			testProvider: testProvider{
+				id:           "cache",
+				dependencies: []string{"config"},
+				boot: func(*dig.Container) error {
+					*booted = true
+					return nil
+				},
+				close: func(*dig.Container) error {
+					*closed = true
+					return nil
+				},
+			},
+			register: func(*dig.Container) error {
+				*registered = true
+				return nil
+			},
+		}
+	}
+
+	t.Run("should register and boot the provider when the condition holds", func(t *testing.T) {
+		config := flam.Bag{}
+		registered, booted, closed := false, false, false
+		conditional, e := flam.NewConditionalProvider(
+			newProvider(&registered, &booted, &closed),
+			flam.BagCondition(config, "cache.driver", "redis"))
+		require.NoError(t, e)
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testProvider{
+			id: "config",
+			boot: func(*dig.Container) error {
+				return config.Set("cache.driver", "redis")
+			},
+		}))
+		require.NoError(t, app.Register(conditional))
+		assert.False(t, registered)
+
+		require.NoError(t, app.Boot())
+		assert.True(t, registered)
+		assert.True(t, booted)
+
+		info := app.Providers()[1]
+		assert.Equal(t, flam.ProviderBooted, info.State)
+		assert.Equal(t, []string{"dependent", "bootable", "runnable", "closable"}, info.Interfaces)
+
+		require.NoError(t, app.Close())
+		assert.True(t, closed)
+	})
+
+	t.Run("should skip the provider when the condition does not hold", func(t *testing.T) {
+		registered, booted, closed := false, false, false
+		conditional, e := flam.NewConditionalProvider(
+			newProvider(&registered, &booted, &closed),
+			flam.BagCondition(flam.Bag{"cache": flam.Bag{"driver": "memory"}}, "cache.driver", "redis"))
+		require.NoError(t, e)
+
+		app := flam.NewApplication()
+		var skipped []any
+		app.Events().Subscribe("test", flam.EventProviderSkipped, func(_, _ string, data ...any) error {
+			skipped = append(skipped, data...)
+			return nil
+		})
+		require.NoError(t, app.Register(&testProvider{id: "config"}))
+		require.NoError(t, app.Register(conditional))
+
+		require.NoError(t, app.Boot())
+		assert.False(t, registered)
+		assert.False(t, booted)
+		assert.Equal(t, []any{"cache"}, skipped)
+
+		infos := app.Providers()
+		assert.Equal(t, flam.ProviderSkipped, infos[1].State)
+		assert.Equal(t, "skipped", infos[1].State.String())
+		assert.Equal(t, []string{"dependent", "conditional"}, infos[1].Interfaces)
+
+		require.NoError(t, app.Close())
+		assert.False(t, closed)
+	})
+
+	t.Run("should skip the providers depending on a skipped provider", func(t *testing.T) {
+		conditional, e := flam.NewConditionalProvider(
+			&testProvider{id: "redis"},
+			flam.BagCondition(flam.Bag{}, "cache.driver", "redis"))
+		require.NoError(t, e)
+
+		app := flam.NewApplication()
+		var skipped []any
+		app.Events().Subscribe("test", flam.EventProviderSkipped, func(_, _ string, data ...any) error {
+			skipped = append(skipped, data...)
+			return nil
+		})
+
+		booted := false
+		boot := func(*dig.Container) error {
+			booted = true
+			return nil
+		}
+		require.NoError(t, app.Register(&testProvider{id: "config"}))
+		require.NoError(t, app.Register(conditional))
+		require.NoError(t, app.Register(&testProvider{id: "warmer", dependencies: []string{"redis"}, boot: boot}))
+		require.NoError(t, app.Register(&testProvider{id: "scheduler", dependencies: []string{"config", "warmer"}, boot: boot}))
+
+		require.NoError(t, app.Boot())
+		assert.False(t, booted)
+		assert.Equal(t, []any{"redis", "warmer", "scheduler"}, skipped)
+
+		infos := app.Providers()
+		assert.Equal(t, flam.ProviderBooted, infos[0].State)
+		assert.Equal(t, flam.ProviderSkipped, infos[1].State)
+		assert.Equal(t, flam.ProviderSkipped, infos[2].State)
+		assert.Equal(t, flam.ProviderSkipped, infos[3].State)
+
+		require.NoError(t, app.Close())
+	})
+
+	t.Run("should serialize the container access of activations and concurrent boots", func(t *testing.T) {
+		app := flam.NewApplication(flam.WithBootWorkers(8))
+		require.NoError(t, app.Container().Provide(func() *testBootA { return &testBootA{} }))
+
+		invoked := atomic.Int32{}
+		invoke := func(*testBootA) { invoked.Add(1) }
+		for i := range 8 {
+			require.NoError(t, app.Register(&testBootContextProvider{
+				id: fmt.Sprintf("context%d", i),
+				boot: func(_ context.Context, container *dig.Container) error {
+					return flam.Invoke(container, invoke)
+				},
+			}))
+
+			id := fmt.Sprintf("conditional%d", i)
+			conditional, e := flam.NewConditionalProvider(
+				&testGraphProvider{
+					testProvider: testProvider{id: id},
+					register: func(container *dig.Container) error {
+						return container.Provide(func() string { return id }, dig.Name(id))
+					},
+				},
+				func(container *dig.Container) (bool, error) {
+					return true, container.Invoke(invoke)
+				})
+			require.NoError(t, e)
+			require.NoError(t, app.Register(conditional))
+		}
+
+		require.NoError(t, app.Boot())
+		assert.Equal(t, int32(16), invoked.Load())
+	})
+
+	t.Run("should fail the boot when the condition evaluation fails", func(t *testing.T) {
+		expectedErr := errors.New("condition error")
+		conditional, e := flam.NewConditionalProvider(
+			&testProvider{id: "cache"},
+			func(*dig.Container) (bool, error) { return false, expectedErr })
+		require.NoError(t, e)
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(conditional))
+
+		assert.ErrorIs(t, app.Boot(), expectedErr)
+		assert.Equal(t, flam.ProviderFailed, app.Providers()[0].State)
+	})
+}
@@ -0,0 +1,75 @@
+//go:build linux || darwin || freebsd || openbsd || netbsd || dragonfly
+
+package tests
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+func Test_PidLockProvider(t *testing.T) {
+	t.Run("should write the process pid in the locked file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "app.pid")
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(flam.NewPidLockProvider(path)))
+		require.NoError(t, app.Boot())
+
+		content, e := os.ReadFile(path)
+		require.NoError(t, e)
+		assert.Equal(t, strconv.Itoa(os.Getpid())+"\n", string(content))
+
+		require.NoError(t, app.Close())
+
+		content, e = os.ReadFile(path)
+		require.NoError(t, e)
+		assert.Empty(t, content)
+	})
+
+	t.Run("should return the holder pid if the lock is already held", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "app.pid")
+
+		holder := flam.NewApplication()
+		require.NoError(t, holder.Register(flam.NewPidLockProvider(path)))
+		require.NoError(t, holder.Boot())
+		defer func() { _ = holder.Close() }()
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(flam.NewPidLockProvider(path)))
+
+		e := app.Boot()
+		assert.ErrorIs(t, e, flam.ErrPidLockHeld)
+
+		var flamErr flam.Error
+		require.True(t, errors.As(e, &flamErr))
+		assert.Equal(t, os.Getpid(), flamErr.Get("pid"))
+		assert.Equal(t, path, flamErr.Get("path"))
+	})
+
+	t.Run("should allow the lock to be acquired after being released", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "app.pid")
+
+		provider := flam.NewPidLockProvider(path)
+		require.NoError(t, provider.Boot(nil))
+		require.NoError(t, provider.Close(nil))
+		require.NoError(t, provider.Close(nil))
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(flam.NewPidLockProvider(path)))
+		assert.NoError(t, app.Boot())
+		assert.NoError(t, app.Close())
+	})
+
+	t.Run("should fail if the pid file cannot be opened", func(t *testing.T) {
+		provider := flam.NewPidLockProvider(filepath.Join(t.TempDir(), "missing", "app.pid"))
+		assert.ErrorIs(t, provider.Boot(nil), os.ErrNotExist)
+	})
+}
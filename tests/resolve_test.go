@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+func Test_Resolve(t *testing.T) {
+	t.Run("should return ErrNilReference when app is nil", func(t *testing.T) {
+		value, e := flam.Resolve[*testVersion](nil)
+		assert.Nil(t, value)
+		assert.ErrorIs(t, e, flam.ErrNilReference)
+	})
+
+	t.Run("should return a flam error with the type on missing type", func(t *testing.T) {
+		value, e := flam.Resolve[*testVersion](flam.NewApplication())
+		assert.Nil(t, value)
+
+		var flamErr flam.Error
+		require.ErrorAs(t, e, &flamErr)
+		assert.Equal(t, "*tests.testVersion", flamErr.Get("type"))
+	})
+
+	t.Run("should resolve the requested type", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Container().Provide(func() *testVersion { return &testVersion{value: "v1"} }))
+
+		value, e := flam.Resolve[*testVersion](app)
+		assert.NoError(t, e)
+		assert.Equal(t, "v1", value.value)
+	})
+}
+
+func Test_MustResolve(t *testing.T) {
+	t.Run("should panic on error", func(t *testing.T) {
+		assert.Panics(t, func() {
+			flam.MustResolve[*testVersion](flam.NewApplication())
+		})
+	})
+
+	t.Run("should return the resolved value", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, app.Container().Provide(func() *testVersion { return &testVersion{value: "v1"} }))
+
+		assert.Equal(t, "v1", flam.MustResolve[*testVersion](app).value)
+	})
+}
+
+func Test_ResolveNamed(t *testing.T) {
+	app := flam.NewApplication()
+	require.NoError(t, app.Container().Provide(func() *testVersion { return &testVersion{value: "primary"} }, dig.Name("primary")))
+
+	t.Run("should return ErrNilReference when app is nil", func(t *testing.T) {
+		_, e := flam.ResolveNamed[*testVersion](nil, "primary")
+		assert.ErrorIs(t, e, flam.ErrNilReference)
+	})
+
+	t.Run("should return a flam error with the type and name on missing type", func(t *testing.T) {
+		value, e := flam.ResolveNamed[*testVersion](app, "secondary")
+		assert.Nil(t, value)
+
+		var flamErr flam.Error
+		require.ErrorAs(t, e, &flamErr)
+		assert.Equal(t, "*tests.testVersion", flamErr.Get("type"))
+		assert.Equal(t, "secondary", flamErr.Get("name"))
+	})
+
+	t.Run("should resolve the named value", func(t *testing.T) {
+		value, e := flam.ResolveNamed[*testVersion](app, "primary")
+		assert.NoError(t, e)
+		assert.Equal(t, "primary", value.value)
+	})
+}
+
+func Test_Provide(t *testing.T) {
+	t.Run("should return ErrNilReference when app is nil", func(t *testing.T) {
+		assert.ErrorIs(t, flam.Provide[*testVersion](nil, func() *testVersion { return nil }), flam.ErrNilReference)
+	})
+
+	t.Run("should return ErrInvalidConstructor when the constructor does not provide the type", func(t *testing.T) {
+		app := flam.NewApplication()
+
+		assert.ErrorIs(t, flam.Provide[*testVersion](app, nil), flam.ErrInvalidConstructor)
+		assert.ErrorIs(t, flam.Provide[*testVersion](app, "constructor"), flam.ErrInvalidConstructor)
+		assert.ErrorIs(t, flam.Provide[*testVersion](app, func() string { return "" }), flam.ErrInvalidConstructor)
+	})
+
+	t.Run("should return a flam error when the container rejects the constructor", func(t *testing.T) {
+		app := flam.NewApplication()
+		constructor := func() *testVersion { return &testVersion{} }
+		require.NoError(t, flam.Provide[*testVersion](app, constructor))
+
+		var flamErr flam.Error
+		require.ErrorAs(t, flam.Provide[*testVersion](app, constructor), &flamErr)
+		assert.Equal(t, "*tests.testVersion", flamErr.Get("type"))
+	})
+
+	t.Run("should provide the type with the given options", func(t *testing.T) {
+		app := flam.NewApplication()
+		require.NoError(t, flam.Provide[*testVersion](app, func() (*testVersion, error) {
+			return &testVersion{value: "named"}, nil
+		}, dig.Name("named")))
+
+		value, e := flam.ResolveNamed[*testVersion](app, "named")
+		assert.NoError(t, e)
+		assert.Equal(t, "named", value.value)
+	})
+}
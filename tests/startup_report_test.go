@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/happyhippyhippo/flam"
+)
+
+func Test_Application_StartupReport(t *testing.T) {
+	t.Run("should collect the provider lifecycle timings", func(t *testing.T) {
+		sleep := func(*dig.Container) error {
+			time.Sleep(5 * time.Millisecond)
+			return nil
+		}
+
+		app := flam.NewApplication()
+		require.NoError(t, app.Register(&testGraphProvider{
+			testProvider: testProvider{id: "provider", boot: sleep, run: sleep, close: sleep},
+			register:     sleep,
+		}))
+		require.NoError(t, app.Register(&testContextProvider{
+			id:  "idle",
+			run: func(context.Context, *dig.Container) error { return nil },
+		}))
+
+		report := app.StartupReport()
+		require.Len(t, report.Providers, 2)
+		assert.GreaterOrEqual(t, report.Providers[0].Register, 5*time.Millisecond)
+		assert.Zero(t, report.Providers[0].Boot)
+
+		require.NoError(t, app.Run())
+		require.NoError(t, app.Close())
+
+		report = app.StartupReport()
+		assert.False(t, report.Started.IsZero())
+		assert.GreaterOrEqual(t, report.Boot, 5*time.Millisecond)
+
+		timing := report.Providers[0]
+		assert.Equal(t, "provider", timing.Id)
+		assert.Equal(t, flam.ProviderClosed, timing.State)
+		assert.GreaterOrEqual(t, timing.Boot, 5*time.Millisecond)
+		assert.GreaterOrEqual(t, timing.RunStart, timing.Register+timing.Boot)
+		assert.GreaterOrEqual(t, timing.Close, 5*time.Millisecond)
+
+		timing = report.Providers[1]
+		assert.Equal(t, "idle", timing.Id)
+		assert.Zero(t, timing.Boot)
+		assert.NotZero(t, timing.RunStart)
+		assert.Zero(t, timing.Close)
+	})
+
+	t.Run("should render the report as a table", func(t *testing.T) {
+		report := flam.StartupReport{
+			Boot: 3 * time.Millisecond,
+			Providers: []flam.ProviderTiming{{
+				Id:       "provider",
+				State:    flam.ProviderBooted,
+				Register: time.Millisecond,
+				Boot:     2 * time.Millisecond,
+			}},
+		}
+
+		expected := "PROVIDER  STATE   REGISTER  BOOT  RUN START  CLOSE\n" +
+			"provider  booted  1ms       2ms   0s         0s\n" +
+			"TOTAL                       3ms              \n"
+		assert.Equal(t, expected, report.Table())
+	})
+
+	t.Run("should print the startup banner after boot", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		app := flam.NewApplication(flam.WithOutput(output), flam.WithStartupBanner())
+		require.NoError(t, app.Register(&testProvider{id: "provider"}))
+
+		require.NoError(t, app.Boot())
+		assert.Contains(t, output.String(), "PROVIDER")
+		assert.Contains(t, output.String(), "provider  booted")
+	})
+
+	t.Run("should not print the startup banner by default", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		app := flam.NewApplication(flam.WithOutput(output))
+		require.NoError(t, app.Register(&testProvider{id: "provider"}))
+
+		require.NoError(t, app.Boot())
+		assert.Empty(t, output.String())
+	})
+}
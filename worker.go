@@ -0,0 +1,7 @@
+package flam
+
+import (
+	"context"
+)
+
+type Worker func(ctx context.Context) error